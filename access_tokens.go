@@ -0,0 +1,152 @@
+package aiven
+
+import (
+	"errors"
+	"time"
+)
+
+type (
+	// AccessTokensHandler is the client that interacts with the user access token endpoints on Aiven.
+	AccessTokensHandler struct {
+		client *Client
+	}
+
+	// AccessToken represents a user access token, the full token is only available upon creation
+	AccessToken struct {
+		TokenPrefix     string     `json:"token_prefix"`
+		Description     string     `json:"description"`
+		Scopes          []string   `json:"scopes"`
+		CreateTime      *time.Time `json:"create_time,omitempty"`
+		ExpiryTime      *time.Time `json:"expiry_time,omitempty"`
+		LastUsedTime    *time.Time `json:"last_used_time,omitempty"`
+		MaxAgeSeconds   *int       `json:"max_age_seconds,omitempty"`
+		ExtendWhenUsed  bool       `json:"extend_when_used"`
+		CreatedManually bool       `json:"created_manually"`
+		CurrentlyActive bool       `json:"currently_active"`
+		LastIP          string     `json:"last_ip,omitempty"`
+		LastUserAgent   string     `json:"last_user_agent,omitempty"`
+	}
+
+	// CreateAccessTokenRequest are the parameters to create an access token
+	CreateAccessTokenRequest struct {
+		Description    string   `json:"description,omitempty"`
+		MaxAgeSeconds  *int     `json:"max_age_seconds,omitempty"`
+		ExtendWhenUsed bool     `json:"extend_when_used,omitempty"`
+		Scopes         []string `json:"scopes,omitempty"`
+	}
+
+	// AccessTokenResponse represents the response from Aiven after creating an access token
+	AccessTokenResponse struct {
+		APIResponse
+		AccessToken
+		FullToken string `json:"full_token"`
+	}
+
+	// AccessTokensResponse represents the response from Aiven for listing access tokens
+	AccessTokensResponse struct {
+		APIResponse
+		Tokens []*AccessToken `json:"tokens"`
+	}
+)
+
+// Create creates a new access token, e.g. to be used as a SCIM provisioning bearer token.
+func (h *AccessTokensHandler) Create(req CreateAccessTokenRequest) (*AccessTokenResponse, error) {
+	bts, err := h.client.doPostRequest(buildPath("access_token"), req)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AccessTokenResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	return &r, nil
+}
+
+// List returns the access tokens of the authenticated user.
+func (h *AccessTokensHandler) List() ([]*AccessToken, error) {
+	bts, err := h.client.doGetRequest(buildPath("access_token"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AccessTokensResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.Tokens, errR
+}
+
+// Revoke revokes the access token with the given prefix.
+func (h *AccessTokensHandler) Revoke(tokenPrefix string) error {
+	if tokenPrefix == "" {
+		return errors.New("cannot revoke an access token when token prefix is empty")
+	}
+
+	bts, err := h.client.doDeleteRequest(buildPath("access_token", tokenPrefix), nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
+
+// TokenInfo returns the access token the client is authenticated with
+func (c *Client) TokenInfo() (*AccessToken, error) {
+	tokens, err := c.AccessTokens.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tokens {
+		if t.CurrentlyActive {
+			return t, nil
+		}
+	}
+
+	return nil, Error{Message: "Access token of the client not found", Status: 404}
+}
+
+// HasScopes returns true if the token grants all of the given scopes. A token
+// created without scopes is not restricted.
+func (t *AccessToken) HasScopes(scopes ...string) bool {
+	if len(t.Scopes) == 0 {
+		return true
+	}
+
+	for _, s := range scopes {
+		if !containsString(t.Scopes, s) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Rotate creates a new access token with the settings of the given one and then revokes the old token.
+func (h *AccessTokensHandler) Rotate(tokenPrefix string) (*AccessTokenResponse, error) {
+	tokens, err := h.List()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tokens {
+		if t.TokenPrefix != tokenPrefix {
+			continue
+		}
+
+		r, err := h.Create(CreateAccessTokenRequest{
+			Description:    t.Description,
+			MaxAgeSeconds:  t.MaxAgeSeconds,
+			ExtendWhenUsed: t.ExtendWhenUsed,
+			Scopes:         t.Scopes,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return r, h.Revoke(tokenPrefix)
+	}
+
+	return nil, Error{Message: "Access token with prefix " + tokenPrefix + " not found", Status: 404}
+}
@@ -0,0 +1,23 @@
+package aiven
+
+import "testing"
+
+func TestAccessToken_HasScopes(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  AccessToken
+		scopes []string
+		want   bool
+	}{
+		{"unrestricted", AccessToken{}, []string{"projects:write"}, true},
+		{"granted", AccessToken{Scopes: []string{"projects:read", "user:read"}}, []string{"user:read"}, true},
+		{"missing", AccessToken{Scopes: []string{"projects:read"}}, []string{"projects:read", "user:read"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.HasScopes(tt.scopes...); got != tt.want {
+				t.Errorf("HasScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,25 +13,45 @@ type (
 
 	// AccountAuthenticationMethod represents account authentication method
 	AccountAuthenticationMethod struct {
-		AccountId                     string     `json:"account_id,omitempty"`
-		Enabled                       bool       `json:"authentication_method_enabled,omitempty"`
-		Id                            string     `json:"authentication_method_id,omitempty"`
-		Name                          string     `json:"authentication_method_name"`
-		Type                          string     `json:"authentication_method_type"`
-		AutoJoinTeamId                string     `json:"auto_join_team_id,omitempty"`
-		State                         string     `json:"state,omitempty"`
-		SAMLCertificate               string     `json:"saml_certificate,omitempty"`
-		SAMLIdpUrl                    string     `json:"saml_idp_url,omitempty"`
-		SAMLEntity                    string     `json:"saml_entity_id,omitempty"`
-		SAMLAcsUrl                    string     `json:"saml_acs_url,omitempty"`
-		SAMLMetadataUrl               string     `json:"saml_metadata_url,omitempty"`
-		SAMLCertificateIssuer         string     `json:"saml_certificate_issuer,omitempty"`
-		SAMLCertificateSubject        string     `json:"saml_certificate_subject,omitempty"`
-		SAMLCertificateNotValidAfter  *time.Time `json:"saml_certificate_not_valid_after,omitempty"`
-		SAMLCertificateNotValidBefore *time.Time `json:"saml_certificate_not_valid_before,omitempty"`
-		CreateTime                    *time.Time `json:"create_time,omitempty"`
-		UpdateTime                    *time.Time `json:"update_time,omitempty"`
-		DeleteTime                    *time.Time `json:"delete_time,omitempty"`
+		AccountId                     string            `json:"account_id,omitempty"`
+		Enabled                       bool              `json:"authentication_method_enabled,omitempty"`
+		Id                            string            `json:"authentication_method_id,omitempty"`
+		Name                          string            `json:"authentication_method_name"`
+		Type                          string            `json:"authentication_method_type"`
+		AutoJoinTeamId                string            `json:"auto_join_team_id,omitempty"`
+		AutoJoinUserGroupId           string            `json:"auto_join_user_group_id,omitempty"`
+		State                         string            `json:"state,omitempty"`
+		SAMLCertificate               string            `json:"saml_certificate,omitempty"`
+		SAMLIdpUrl                    string            `json:"saml_idp_url,omitempty"`
+		SAMLEntity                    string            `json:"saml_entity_id,omitempty"`
+		SAMLAcsUrl                    string            `json:"saml_acs_url,omitempty"`
+		SAMLMetadataUrl               string            `json:"saml_metadata_url,omitempty"`
+		SAMLCertificateIssuer         string            `json:"saml_certificate_issuer,omitempty"`
+		SAMLCertificateSubject        string            `json:"saml_certificate_subject,omitempty"`
+		SAMLCertificateNotValidAfter  *time.Time        `json:"saml_certificate_not_valid_after,omitempty"`
+		SAMLCertificateNotValidBefore *time.Time        `json:"saml_certificate_not_valid_before,omitempty"`
+		SAMLFieldMapping              *SAMLFieldMapping `json:"saml_field_mapping,omitempty"`
+		SCIMEnabled                   bool              `json:"scim_enabled,omitempty"`
+		SCIMUrl                       string            `json:"scim_url,omitempty"`
+		CreateTime                    *time.Time        `json:"create_time,omitempty"`
+		UpdateTime                    *time.Time        `json:"update_time,omitempty"`
+		DeleteTime                    *time.Time        `json:"delete_time,omitempty"`
+	}
+
+	// SAMLFieldMapping maps the SAML assertion attributes to the user fields on Aiven
+	SAMLFieldMapping struct {
+		Email     string `json:"email,omitempty"`
+		FirstName string `json:"first_name,omitempty"`
+		Identity  string `json:"identity,omitempty"`
+		LastName  string `json:"last_name,omitempty"`
+		RealName  string `json:"real_name,omitempty"`
+	}
+
+	// AccountAuthenticationAutoJoin is the team and organization user group users signing in
+	// with an authentication method for the first time are added to
+	AccountAuthenticationAutoJoin struct {
+		TeamId      string
+		UserGroupId string
 	}
 
 	// AccountAuthenticationsResponse represents account list of available authentication methods API response
@@ -141,3 +161,53 @@ func (h AccountAuthenticationsHandler) Delete(accountId, authId string) error {
 
 	return checkAPIResponse(bts, nil)
 }
+
+// GetAutoJoin returns the team and user group new users of an account authentication method join
+func (h AccountAuthenticationsHandler) GetAutoJoin(accountId, authId string) (*AccountAuthenticationAutoJoin, error) {
+	rsp, err := h.Get(accountId, authId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AccountAuthenticationAutoJoin{
+		TeamId:      rsp.AuthenticationMethod.AutoJoinTeamId,
+		UserGroupId: rsp.AuthenticationMethod.AutoJoinUserGroupId,
+	}, nil
+}
+
+// SetAutoJoin sets the team and user group new users of an account authentication method join,
+// empty IDs are left unchanged. The team must belong to the account.
+func (h AccountAuthenticationsHandler) SetAutoJoin(accountId, authId string, a AccountAuthenticationAutoJoin) (*AccountAuthenticationResponse, error) {
+	if a.TeamId != "" {
+		teams, err := h.client.AccountTeams.List(accountId)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, t := range teams.Teams {
+			if t.Id == a.TeamId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, Error{Message: "Team " + a.TeamId + " not found in account " + accountId, Status: 404}
+		}
+	}
+
+	rsp, err := h.Get(accountId, authId)
+	if err != nil {
+		return nil, err
+	}
+
+	m := rsp.AuthenticationMethod
+	if a.TeamId != "" {
+		m.AutoJoinTeamId = a.TeamId
+	}
+	if a.UserGroupId != "" {
+		m.AutoJoinUserGroupId = a.UserGroupId
+	}
+
+	return h.Update(accountId, m)
+}
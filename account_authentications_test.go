@@ -491,3 +491,90 @@ func TestAccountAuthenticationsHandler_Get(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountAuthenticationsHandler_AutoJoin(t *testing.T) {
+	var updates []AccountAuthenticationMethod
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var rsp interface{}
+		switch {
+		case r.URL.Path == "/account/a28707e316df/teams" && r.Method == "GET":
+			rsp = AccountTeamsResponse{Teams: []AccountTeam{{Id: "at28707ea77e2", Name: "Account Owners"}}}
+		case r.URL.Path == "/account/a28707e316df/authentication/am28707eb0055" && r.Method == "GET":
+			rsp = AccountAuthenticationResponse{AuthenticationMethod: AccountAuthenticationMethod{
+				AccountId:           "a28707e316df",
+				Id:                  "am28707eb0055",
+				Name:                "Okta",
+				Type:                "saml",
+				AutoJoinTeamId:      "at28707ea77e2",
+				AutoJoinUserGroupId: "ug28707ea77e3",
+			}}
+		case r.URL.Path == "/account/a28707e316df/authentication/am28707eb0055" && r.Method == "PUT":
+			var m AccountAuthenticationMethod
+			if err := json.NewDecoder(r.Body).Decode(&m); err != nil {
+				t.Error(err)
+			}
+			updates = append(updates, m)
+			rsp = AccountAuthenticationResponse{AuthenticationMethod: m}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if err := json.NewEncoder(w).Encode(rsp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	got, err := c.AccountAuthentications.GetAutoJoin("a28707e316df", "am28707eb0055")
+	if err != nil {
+		t.Fatalf("GetAutoJoin() error = %v", err)
+	}
+	if want := (AccountAuthenticationAutoJoin{TeamId: "at28707ea77e2", UserGroupId: "ug28707ea77e3"}); *got != want {
+		t.Errorf("GetAutoJoin() got = %v, want %v", *got, want)
+	}
+
+	// a team outside of the account is refused without updating
+	_, err = c.AccountAuthentications.SetAutoJoin("a28707e316df", "am28707eb0055",
+		AccountAuthenticationAutoJoin{TeamId: "at28707ea0000"})
+	if !IsNotFound(err) {
+		t.Errorf("SetAutoJoin() error = %v, want not found", err)
+	}
+	if len(updates) != 0 {
+		t.Fatalf("SetAutoJoin() updated %v, want no update", updates)
+	}
+
+	// the empty team ID is left unchanged
+	rsp, err := c.AccountAuthentications.SetAutoJoin("a28707e316df", "am28707eb0055",
+		AccountAuthenticationAutoJoin{UserGroupId: "ug28707ea0001"})
+	if err != nil {
+		t.Fatalf("SetAutoJoin() error = %v", err)
+	}
+	if len(updates) != 1 {
+		t.Fatalf("SetAutoJoin() updated %d times, want 1", len(updates))
+	}
+	m := updates[0]
+	if m.AutoJoinTeamId != "at28707ea77e2" || m.AutoJoinUserGroupId != "ug28707ea0001" ||
+		m.Name != "Okta" || m.Type != "saml" {
+		t.Errorf("SetAutoJoin() updated to %v", m)
+	}
+	if rsp.AuthenticationMethod.AutoJoinUserGroupId != "ug28707ea0001" {
+		t.Errorf("SetAutoJoin() got = %v", rsp.AuthenticationMethod)
+	}
+
+	// the empty user group ID is left unchanged
+	if _, err := c.AccountAuthentications.SetAutoJoin("a28707e316df", "am28707eb0055",
+		AccountAuthenticationAutoJoin{TeamId: "at28707ea77e2"}); err != nil {
+		t.Fatalf("SetAutoJoin() error = %v", err)
+	}
+	if m := updates[1]; m.AutoJoinTeamId != "at28707ea77e2" || m.AutoJoinUserGroupId != "ug28707ea77e3" {
+		t.Errorf("SetAutoJoin() updated to %v", m)
+	}
+}
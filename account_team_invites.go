@@ -29,7 +29,8 @@ type (
 	}
 )
 
-// List returns a list of all available account invitations
+// List returns a list of all available account invitations. The API returns every invitation
+// of the team in a single response, there are no further pages to fetch.
 func (h AccountTeamInvitesHandler) List(accountId, teamId string) (*AccountTeamInvitesResponse, error) {
 	if accountId == "" || teamId == "" {
 		return nil, errors.New("cannot get a list of account team invites when account id or team id is empty")
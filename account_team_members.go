@@ -30,6 +30,12 @@ type (
 		Members []AccountTeamMember `json:"members"`
 	}
 
+	// AccountTeamMemberInviteResult represents the outcome of inviting a single team member
+	AccountTeamMemberInviteResult struct {
+		Email string
+		Err   error
+	}
+
 	// AccountTeamMemberResponse represents a account team member API response
 	AccountTeamMemberResponse struct {
 		APIResponse
@@ -37,7 +43,8 @@ type (
 	}
 )
 
-// List returns a list of all existing account team members
+// List returns a list of all existing account team members. The API returns every member
+// of the team in a single response, there are no further pages to fetch.
 func (h AccountTeamMembersHandler) List(accountId, teamId string) (*AccountTeamMembersResponse, error) {
 	if accountId == "" || teamId == "" {
 		return nil, errors.New("cannot get a list of team members when account id or team id is empty")
@@ -77,6 +84,19 @@ func (h AccountTeamMembersHandler) Invite(accountId, teamId, email string) error
 	return checkAPIResponse(bts, nil)
 }
 
+// InviteMany invites team members concurrently and returns a result per email in the given order
+func (h AccountTeamMembersHandler) InviteMany(accountId, teamId string, emails []string) []AccountTeamMemberInviteResult {
+	results := make([]AccountTeamMemberInviteResult, len(emails))
+	forEachParallel(len(emails), func(i int) {
+		results[i] = AccountTeamMemberInviteResult{
+			Email: emails[i],
+			Err:   h.Invite(accountId, teamId, emails[i]),
+		}
+	})
+
+	return results
+}
+
 // Delete deletes an existing account team member
 func (h AccountTeamMembersHandler) Delete(accountId, teamId, userId string) error {
 	if accountId == "" || teamId == "" || userId == "" {
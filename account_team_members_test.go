@@ -302,3 +302,23 @@ func TestAccountTeamMembersHandler_Invite(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountTeamMembersHandler_InviteMany(t *testing.T) {
+	c, tearDown := setupAccountsTeamMembersTestCase(t)
+	defer tearDown(t)
+
+	emails := []string{"test1@example.com", "", "test2@example.com"}
+	results := c.AccountTeamMembers.InviteMany("a28707e316df", "at28707ea77e2", emails)
+	if len(results) != len(emails) {
+		t.Fatalf("InviteMany() got %d results, want %d", len(results), len(emails))
+	}
+
+	for i, r := range results {
+		if r.Email != emails[i] {
+			t.Errorf("InviteMany() result %d email = %v, want %v", i, r.Email, emails[i])
+		}
+		if wantErr := emails[i] == ""; (r.Err != nil) != wantErr {
+			t.Errorf("InviteMany() result %d error = %v, wantErr %v", i, r.Err, wantErr)
+		}
+	}
+}
@@ -15,6 +15,17 @@ type (
 		TeamType string `json:"team_type,omitempty"`
 	}
 
+	// ProjectPermissions are the capabilities a project member type grants
+	ProjectPermissions struct {
+		ManageMembers   bool
+		ManageBilling   bool
+		CreateServices  bool
+		DeleteServices  bool
+		ManageServices  bool
+		ViewCredentials bool
+		ReadOnly        bool
+	}
+
 	// AccountTeamProjectsResponse represents account team list of associated projects API response
 	AccountTeamProjectsResponse struct {
 		APIResponse
@@ -22,6 +33,42 @@ type (
 	}
 )
 
+// projectPermissions maps the project member types to the capabilities they grant
+var projectPermissions = map[string]ProjectPermissions{
+	"admin": {
+		ManageMembers:   true,
+		ManageBilling:   true,
+		CreateServices:  true,
+		DeleteServices:  true,
+		ManageServices:  true,
+		ViewCredentials: true,
+	},
+	"operator": {
+		CreateServices:  true,
+		DeleteServices:  true,
+		ManageServices:  true,
+		ViewCredentials: true,
+	},
+	"developer": {
+		ManageServices:  true,
+		ViewCredentials: true,
+	},
+	"read_only": {
+		ReadOnly: true,
+	},
+}
+
+// PermissionsForTeamType returns the capabilities granted by a team type or project member
+// type, which is one of admin, developer, operator and read_only
+func PermissionsForTeamType(teamType string) (*ProjectPermissions, error) {
+	p, ok := projectPermissions[teamType]
+	if !ok {
+		return nil, errors.New("unknown team type: " + teamType)
+	}
+
+	return &p, nil
+}
+
 // List returns a list of all existing account team projects
 func (h AccountTeamProjectsHandler) List(accountId, teamId string) (*AccountTeamProjectsResponse, error) {
 	if accountId == "" || teamId == "" {
@@ -97,3 +144,19 @@ func (h AccountTeamProjectsHandler) Delete(accountId, teamId, projectName string
 
 	return checkAPIResponse(bts, nil)
 }
+
+// EffectivePermissions returns the capabilities the team has in the project
+func (h AccountTeamProjectsHandler) EffectivePermissions(accountId, teamId, projectName string) (*ProjectPermissions, error) {
+	rsp, err := h.List(accountId, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range rsp.Projects {
+		if p.ProjectName == projectName {
+			return PermissionsForTeamType(p.TeamType)
+		}
+	}
+
+	return nil, Error{Message: "Team " + teamId + " is not associated with project " + projectName, Status: 404}
+}
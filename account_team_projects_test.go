@@ -391,3 +391,20 @@ func TestAccountTeamProjectsHandler_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountTeamProjectsHandler_EffectivePermissions(t *testing.T) {
+	c, tearDown := setupAccountTeamProjectsTestCase(t)
+	defer tearDown(t)
+
+	got, err := c.AccountTeamProjects.EffectivePermissions("a28707e316df", "at28707ea77e2", "test-pr")
+	if err != nil {
+		t.Fatalf("EffectivePermissions() error = %v", err)
+	}
+	if !got.ManageMembers || !got.CreateServices || got.ReadOnly {
+		t.Errorf("EffectivePermissions() got = %+v, want admin permissions", got)
+	}
+
+	if _, err := c.AccountTeamProjects.EffectivePermissions("a28707e316df", "at28707ea77e2", "other-pr"); !IsNotFound(err) {
+		t.Errorf("EffectivePermissions() error = %v, want not found", err)
+	}
+}
@@ -34,6 +34,10 @@ type (
 		UpdateTime     *time.Time `json:"update_time,omitempty"`
 		BillingEnabled bool       `json:"account_billing_enabled,omitempty"`
 		TenantId       string     `json:"tenant_id,omitempty"`
+		OrganizationId string     `json:"organization_id,omitempty"`
+		ParentId       string     `json:"parent_account_id,omitempty"`
+		RootId         string     `json:"root_account_id,omitempty"`
+		IsOwner        bool       `json:"is_account_owner,omitempty"`
 	}
 )
 
@@ -0,0 +1,49 @@
+package aiven
+
+type (
+	// AlertsHandler is the client that interacts with the alerts API on Aiven. Alert rules
+	// and thresholds are managed by Aiven and can't be configured, notifications of the
+	// alerts are sent to the technical contacts of the project, see Project.TechnicalEmails.
+	AlertsHandler struct {
+		client *Client
+	}
+
+	// Alert represents an active alert of a project or service
+	Alert struct {
+		CreateTime  string `json:"create_time"`
+		Event       string `json:"event"`
+		NodeName    string `json:"node_name,omitempty"`
+		ProjectName string `json:"project_name"`
+		ServiceName string `json:"service_name,omitempty"`
+		ServiceType string `json:"service_type,omitempty"`
+		Severity    string `json:"severity"`
+	}
+
+	// AlertsResponse represents the response from Aiven for listing alerts
+	AlertsResponse struct {
+		APIResponse
+		Alerts []*Alert `json:"alerts"`
+	}
+)
+
+// List returns the active alerts of the project.
+func (h *AlertsHandler) List(project string) ([]*Alert, error) {
+	return h.list(buildPath("project", project, "alerts"))
+}
+
+// ListForService returns the active alerts of the service.
+func (h *AlertsHandler) ListForService(project, service string) ([]*Alert, error) {
+	return h.list(buildPath("project", project, "service", service, "alerts"))
+}
+
+func (h *AlertsHandler) list(path string) ([]*Alert, error) {
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r AlertsResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.Alerts, errR
+}
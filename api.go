@@ -4,12 +4,18 @@
 package aiven
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
 )
 
+// maxParallelRequests limits the number of concurrent requests issued by bulk helpers
+const maxParallelRequests = 10
+
 // APIResponse represents a response returned by the Aiven API.
 type APIResponse struct {
 	Errors  []Error `json:"errors,omitempty"`
@@ -37,7 +43,7 @@ func checkAPIResponse(bts []byte, r Response) error {
 		r = new(APIResponse)
 	}
 
-	if err := json.Unmarshal(bts, &r); err != nil {
+	if err := unmarshalJSON(bts, &r); err != nil {
 		return fmt.Errorf("cannot unmarshal JSON `%s`, error: %w", bts, err)
 	}
 
@@ -48,6 +54,50 @@ func checkAPIResponse(bts []byte, r Response) error {
 	return r.GetError()
 }
 
+// unmarshalJSON decodes JSON keeping numbers in untyped fields (user configs, metadata)
+// as json.Number, so large integers such as offsets or byte counts don't lose precision
+func unmarshalJSON(bts []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(bts))
+	d.UseNumber()
+	return d.Decode(v)
+}
+
+// ToInt64 converts a numeric value of an untyped field, e.g. a user config or metadata entry, to int64
+func ToInt64(v interface{}) (int64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Int64()
+	case float64:
+		return int64(n), nil
+	case int:
+		return int64(n), nil
+	case int64:
+		return n, nil
+	case string:
+		return strconv.ParseInt(n, 10, 64)
+	default:
+		return 0, fmt.Errorf("cannot convert %v of type %T to int64", v, v)
+	}
+}
+
+// ToFloat64 converts a numeric value of an untyped field, e.g. a user config or metadata entry, to float64
+func ToFloat64(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case json.Number:
+		return n.Float64()
+	case float64:
+		return n, nil
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case string:
+		return strconv.ParseFloat(n, 64)
+	default:
+		return 0, fmt.Errorf("cannot convert %v of type %T to float64", v, v)
+	}
+}
+
 func buildPath(parts ...string) string {
 	finalParts := make([]string, len(parts))
 	for idx, part := range parts {
@@ -55,3 +105,30 @@ func buildPath(parts ...string) string {
 	}
 	return "/" + strings.Join(finalParts, "/")
 }
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// forEachParallel calls fn for every index in [0, n) running at most maxParallelRequests calls at once
+func forEachParallel(n int, fn func(i int)) {
+	sem := make(chan struct{}, maxParallelRequests)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i)
+		}(i)
+	}
+	wg.Wait()
+}
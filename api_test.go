@@ -1,6 +1,9 @@
 package aiven
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func Test_checkAPIResponse(t *testing.T) {
 	type args struct {
@@ -103,3 +106,46 @@ func TestAPIResponse_GetError(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkAPIResponse_numberPrecision(t *testing.T) {
+	var r ServiceResponse
+	bts := []byte(`{"service": {"user_config": {"kafka": {"log_retention_bytes": 9007199254740993}}}}`)
+	if err := checkAPIResponse(bts, &r); err != nil {
+		t.Fatalf("checkAPIResponse() error = %v", err)
+	}
+
+	got, err := ToInt64(r.Service.UserConfig["kafka"].(map[string]interface{})["log_retention_bytes"])
+	if err != nil {
+		t.Fatalf("ToInt64() error = %v", err)
+	}
+	if got != 9007199254740993 {
+		t.Errorf("ToInt64() = %v, want %v", got, int64(9007199254740993))
+	}
+}
+
+func TestToFloat64(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    float64
+		wantErr bool
+	}{
+		{"json-number", json.Number("0.5"), 0.5, false},
+		{"float", 1.5, 1.5, false},
+		{"int", 2, 2, false},
+		{"string", "2.5", 2.5, false},
+		{"bool", true, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ToFloat64(tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ToFloat64() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ToFloat64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
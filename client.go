@@ -5,14 +5,26 @@ package aiven
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimitBackoff is how long to wait before retrying a rate limited request when Aiven
+// doesn't tell how long to wait, maxRateLimitBackoff caps the wait Aiven asks for
+var (
+	rateLimitBackoff    = time.Second
+	maxRateLimitBackoff = time.Minute
 )
 
 // APIURL is the URL we'll use to speak to Aiven. This can be overwritten.
@@ -33,6 +45,26 @@ type Client struct {
 	Client    *http.Client
 	UserAgent string
 
+	// Logger receives warnings of the client, which are discarded when it is not set
+	Logger Logger
+
+	// BaseURLPath is prepended to the API paths when Aiven is served under a path prefix,
+	// e.g. by a gateway mounting it at https://gw.example.com/aiven
+	BaseURLPath string
+
+	// ResponseHook when set is called with every response received from Aiven, including
+	// those of retried requests, e.g. to read rate limit headers or the request ID. The body
+	// of the response must not be read.
+	ResponseHook func(rsp *http.Response)
+
+	// CredentialProvider when set is used to obtain and refresh APIKey
+	CredentialProvider CredentialProvider
+	tokenMu            sync.Mutex
+	tokenExpiry        time.Time
+
+	// ctx bounds the requests of the client, see WithContext
+	ctx context.Context
+
 	Projects                        *ProjectsHandler
 	ProjectUsers                    *ProjectUsersHandler
 	CA                              *CAHandler
@@ -66,6 +98,12 @@ type Client struct {
 	FlinkJobs                       *FlinkJobHandler
 	FlinkTables                     *FlinkTableHandler
 	AzurePrivatelink                *AzurePrivatelinkHandler
+	OrganizationApplicationUsers    *OrganizationApplicationUsersHandler
+	AccessTokens                    *AccessTokensHandler
+	StaticIPs                       *StaticIPsHandler
+	OrganizationDomains             *OrganizationDomainsHandler
+	OrganizationUserGroups          *OrganizationUserGroupsHandler
+	Alerts                          *AlertsHandler
 }
 
 // GetUserAgentOrDefault configures a default userAgent value, if one has not been provided.
@@ -77,23 +115,34 @@ func GetUserAgentOrDefault(userAgent string) string {
 }
 
 // NewMFAUserClient creates a new client based on email, one-time password and password.
+// Without a one-time password the client signs in again when its token expires, a one-time
+// password can't be reused so the token of a client created with one is not refreshed.
 func NewMFAUserClient(email, otp, password string, userAgent string) (*Client, error) {
-	c := &Client{
-		Client:    buildHttpClient(),
-		UserAgent: GetUserAgentOrDefault(userAgent),
-	}
-
-	bts, err := c.doPostRequest("/userauth", authRequest{email, otp, password})
+	httpClient, err := buildHttpClient()
 	if err != nil {
 		return nil, err
 	}
 
-	var r authResponse
-	if err := checkAPIResponse(bts, &r); err != nil {
+	p := &userCredentialProvider{
+		client: &Client{
+			Client:    httpClient,
+			UserAgent: GetUserAgentOrDefault(userAgent),
+		},
+		email:    email,
+		otp:      otp,
+		password: password,
+	}
+
+	if otp == "" {
+		return NewCredentialProviderClient(p, userAgent)
+	}
+
+	token, _, err := p.Token(context.Background())
+	if err != nil {
 		return nil, err
 	}
 
-	return NewTokenClient(r.Token, userAgent)
+	return NewTokenClient(token, userAgent)
 }
 
 // NewUserClient creates a new client based on email and password.
@@ -103,9 +152,14 @@ func NewUserClient(email, password string, userAgent string) (*Client, error) {
 
 // NewTokenClient creates a new client based on a given token.
 func NewTokenClient(key string, userAgent string) (*Client, error) {
+	httpClient, err := buildHttpClient()
+	if err != nil {
+		return nil, err
+	}
+
 	c := &Client{
 		APIKey:    key,
-		Client:    buildHttpClient(),
+		Client:    httpClient,
 		UserAgent: GetUserAgentOrDefault(userAgent),
 	}
 	c.Init()
@@ -114,17 +168,19 @@ func NewTokenClient(key string, userAgent string) (*Client, error) {
 }
 
 // buildHttpClient it builds http.Client, if environment variable AIVEN_CA_CERT
-// contains a path to a valid CA certificate HTTPS client will be configured to use it
-func buildHttpClient() *http.Client {
+// contains a path to a valid CA certificate HTTPS client will be configured to use it.
+// A file without any certificates is an error, the client Logger can't be set yet to
+// warn about it.
+func buildHttpClient() (*http.Client, error) {
 	caFilename := os.Getenv("AIVEN_CA_CERT")
 	if caFilename == "" {
-		return &http.Client{}
+		return &http.Client{}, nil
 	}
 
 	// Load CA cert
 	caCert, err := ioutil.ReadFile(caFilename)
 	if err != nil {
-		log.Fatal("cannot load ca cert: %w", err)
+		return nil, fmt.Errorf("cannot load ca cert: %w", err)
 	}
 
 	// Append CA cert to the system pool
@@ -134,7 +190,7 @@ func buildHttpClient() *http.Client {
 	}
 
 	if ok := caCertPool.AppendCertsFromPEM(caCert); !ok {
-		log.Println("[WARNING] No certs appended, using system certs only")
+		return nil, fmt.Errorf("no certificates found in ca cert %s", caFilename)
 	}
 
 	// Setup HTTPS client
@@ -144,7 +200,7 @@ func buildHttpClient() *http.Client {
 	transport := &http.Transport{TLSClientConfig: tlsConfig}
 	client := &http.Client{Transport: transport}
 
-	return client
+	return client, nil
 }
 
 // Init initializes the client and sets up all the handlers.
@@ -182,6 +238,12 @@ func (c *Client) Init() {
 	c.FlinkJobs = &FlinkJobHandler{c}
 	c.FlinkTables = &FlinkTableHandler{c}
 	c.AzurePrivatelink = &AzurePrivatelinkHandler{c}
+	c.OrganizationApplicationUsers = &OrganizationApplicationUsersHandler{c}
+	c.AccessTokens = &AccessTokensHandler{c}
+	c.StaticIPs = &StaticIPsHandler{c}
+	c.OrganizationDomains = &OrganizationDomainsHandler{c}
+	c.OrganizationUserGroups = &OrganizationUserGroupsHandler{c}
+	c.Alerts = &AlertsHandler{c}
 }
 
 func (c *Client) doGetRequest(endpoint string, req interface{}) ([]byte, error) {
@@ -220,67 +282,192 @@ func (c *Client) doV2DeleteRequest(endpoint string, req interface{}) ([]byte, er
 	return c.doRequest("DELETE", endpoint, req, 2)
 }
 
+// WithContext returns a copy of the client whose requests are bound to the context, e.g. to
+// give a slow service creation a longer deadline than reads made with the original client.
+// The copy shares the HTTP client and credentials of the original client.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	c.tokenMu.Lock()
+	apiKey, tokenExpiry := c.APIKey, c.tokenExpiry
+	c.tokenMu.Unlock()
+
+	cc := &Client{
+		APIKey:             apiKey,
+		Client:             c.Client,
+		UserAgent:          c.UserAgent,
+		Logger:             c.Logger,
+		BaseURLPath:        c.BaseURLPath,
+		CredentialProvider: c.CredentialProvider,
+		ResponseHook:       c.ResponseHook,
+		tokenExpiry:        tokenExpiry,
+		ctx:                ctx,
+	}
+	cc.Init()
+
+	return cc
+}
+
+// context returns the context the requests of the client are bound to
+func (c *Client) context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
 func (c *Client) doRequest(method, uri string, body interface{}, apiVersion int) ([]byte, error) {
+	bts, _, err := c.doRequestRaw(method, uri, body, apiVersion)
+	return bts, err
+}
+
+// doRequestRaw does the request like doRequest and also returns the final response, e.g. to
+// read its headers. The body of the response has been read and closed.
+func (c *Client) doRequestRaw(method, uri string, body interface{}, apiVersion int) ([]byte, *http.Response, error) {
 	var bts []byte
 	if body != nil {
 		var err error
 		bts, err = json.Marshal(body)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
 	var url string
 	switch apiVersion {
 	case 1:
-		url = endpoint(uri)
+		url = endpoint(c.BaseURLPath, uri)
 	case 2:
-		url = endpointV2(uri)
+		url = endpointV2(c.BaseURLPath, uri)
 	default:
-		return nil, fmt.Errorf("aiven API apiVersion `%d` is not supported", apiVersion)
+		return nil, nil, fmt.Errorf("aiven API apiVersion `%d` is not supported", apiVersion)
 	}
 
 	retryCount := 2
+	reauthenticated := false
 	for {
-		req, err := http.NewRequest(method, url, bytes.NewBuffer(bts))
+		req, err := http.NewRequestWithContext(c.context(), method, url, bytes.NewBuffer(bts))
 		if err != nil {
-			return nil, err
+			return nil, nil, err
+		}
+
+		token, err := c.token()
+		if err != nil {
+			return nil, nil, err
 		}
 
 		req.Header.Set("Content-Type", "application/json")
 		req.Header.Set("User-Agent", c.UserAgent)
-		req.Header.Set("Authorization", "aivenv1 "+c.APIKey)
+		req.Header.Set("Authorization", "aivenv1 "+token)
 
 		rsp, err := c.Client.Do(req)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		defer func() {
 			err := rsp.Body.Close()
 			if err != nil {
-				log.Printf("[WARNING] cannot close response body: %s \n", err)
+				c.logger().Printf("[WARNING] cannot close response body: %s \n", err)
 			}
 		}()
 
 		responseBody, err := ioutil.ReadAll(rsp.Body)
+		if c.ResponseHook != nil {
+			c.ResponseHook(rsp)
+		}
+
+		// Retry once with a new token when the token is rejected, e.g. because it expired
+		if rsp.StatusCode == 401 && c.CredentialProvider != nil && !reauthenticated {
+			reauthenticated = true
+			c.logger().Printf("[DEBUG] %s %s rejected the token, retrying with a new one\n", method, url)
+			c.invalidateToken(token)
+			continue
+		}
+
+		// Retry a few times when rate limited, after the time Aiven asks to wait. The request
+		// was not processed, so it is safe to retry with any method.
+		if rsp.StatusCode == 429 && retryCount > 0 {
+			retryCount--
+			d := retryAfter(rsp.Header, time.Now())
+			c.logger().Printf("[DEBUG] %s %s was rate limited, retrying in %s\n", method, url, d)
+			if err := c.wait(d); err != nil {
+				return nil, rsp, err
+			}
+			continue
+		}
+
 		// Retry a few times in case of request timeout or server error for GET requests
 		if (rsp.StatusCode == 408 || rsp.StatusCode >= 500) && retryCount > 0 && method == "GET" {
 			retryCount--
+			c.logger().Printf("[DEBUG] %s %s failed with status %d, retrying\n", method, url, rsp.StatusCode)
 			continue
 		} else if rsp.StatusCode < 200 || rsp.StatusCode >= 300 {
-			return nil, Error{Message: string(responseBody), Status: rsp.StatusCode}
+			e := newError(responseBody, rsp.StatusCode)
+			e.RequestID = rsp.Header.Get("X-Request-Id")
+			return nil, rsp, e
+		}
+
+		return responseBody, rsp, err
+	}
+}
+
+// retryAfter returns how long to wait before retrying a rate limited request, as told by the
+// Retry-After header in either delta-seconds or HTTP-date form
+func retryAfter(h http.Header, now time.Time) time.Duration {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return rateLimitBackoff
+	}
+
+	d := rateLimitBackoff
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		d = time.Duration(seconds) * time.Second
+	} else if t, err := http.ParseTime(v); err == nil {
+		d = t.Sub(now)
+		if d < 0 {
+			d = 0
 		}
+	}
+
+	if d > maxRateLimitBackoff {
+		return maxRateLimitBackoff
+	}
+	return d
+}
+
+// wait sleeps for the duration unless the context of the client is done first
+func (c *Client) wait(d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
 
-		return responseBody, err
+	select {
+	case <-t.C:
+		return nil
+	case <-c.context().Done():
+		return c.context().Err()
 	}
 }
 
-func endpoint(uri string) string {
-	return apiurl + uri
+func endpoint(prefix, uri string) string {
+	return withBaseURLPath(apiurl, prefix) + uri
 }
 
-func endpointV2(uri string) string {
-	return apiurlV2 + uri
+func endpointV2(prefix, uri string) string {
+	return withBaseURLPath(apiurlV2, prefix) + uri
+}
+
+// withBaseURLPath inserts the path prefix between the host and the path of the API URL
+func withBaseURLPath(apiURL, prefix string) string {
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return apiURL
+	}
+
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return apiURL
+	}
+	u.Path = "/" + prefix + u.Path
+
+	return u.String()
 }
 
 // ToStringPointer converts string to a string pointer
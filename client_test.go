@@ -1,10 +1,145 @@
 package aiven
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestClient_Init(t *testing.T) {
 	var c Client = Client{}
 	c.Init()
 }
+
+func Test_withBaseURLPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiURL string
+		prefix string
+		want   string
+	}{
+		{"no prefix", "https://api.aiven.io/v1", "", "https://api.aiven.io/v1"},
+		{"prefix", "https://gw.example.com/v1", "/aiven", "https://gw.example.com/aiven/v1"},
+		{"nested prefix with slashes", "https://gw.example.com/v2", "/proxy/aiven/", "https://gw.example.com/proxy/aiven/v2"},
+		{"no version", "http://127.0.0.1:8080", "aiven", "http://127.0.0.1:8080/aiven"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withBaseURLPath(tt.apiURL, tt.prefix); got != tt.want {
+				t.Errorf("withBaseURLPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_WithContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"projects": []}`))
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.WithContext(ctx).Projects.List(); err == nil {
+		t.Errorf("WithContext() request did not time out")
+	}
+
+	if _, err := c.Projects.List(); err != nil {
+		t.Errorf("request without context failed: %v", err)
+	}
+}
+
+func Test_retryAfter(t *testing.T) {
+	now := time.Date(2021, 6, 16, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{"absent", "", rateLimitBackoff},
+		{"seconds", "5", 5 * time.Second},
+		{"date", "Wed, 16 Jun 2021 12:00:30 GMT", 30 * time.Second},
+		{"past-date", "Wed, 16 Jun 2021 11:00:00 GMT", 0},
+		{"capped", "3600", maxRateLimitBackoff},
+		{"invalid", "soon", rateLimitBackoff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			if tt.value != "" {
+				h.Set("Retry-After", tt.value)
+			}
+
+			if got := retryAfter(h, now); got != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_rateLimited(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"project": {"project_name": "test-pr"}}`))
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	if _, err := c.Projects.Create(CreateProjectRequest{Project: "test-pr"}); err != nil {
+		t.Errorf("rate limited request was not retried: %v", err)
+	}
+
+	if calls != 2 {
+		t.Errorf("calls = %d, want 2", calls)
+	}
+}
+
+func TestClient_ResponseHook(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusConflict)
+		_, _ = w.Write([]byte(`{"message": "Project already exists"}`))
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	var requestIDs []string
+	c := &Client{
+		APIKey: "some-random-token",
+		Client: &http.Client{},
+		ResponseHook: func(rsp *http.Response) {
+			requestIDs = append(requestIDs, rsp.Header.Get("X-Request-Id"))
+		},
+	}
+	c.Init()
+
+	_, err := c.Projects.Create(CreateProjectRequest{Project: "test-pr"})
+	if e, ok := err.(Error); !ok || e.RequestID != "req-1" {
+		t.Errorf("Create() error = %v, want request ID req-1", err)
+	}
+
+	if len(requestIDs) != 1 || requestIDs[0] != "req-1" {
+		t.Errorf("ResponseHook() got request IDs %v", requestIDs)
+	}
+}
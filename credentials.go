@@ -0,0 +1,97 @@
+package aiven
+
+import (
+	"context"
+	"time"
+)
+
+// tokenExpiryMargin is how long before the reported expiry a provided token is refreshed
+const tokenExpiryMargin = 30 * time.Second
+
+// CredentialProvider supplies API tokens to the client, for example from Vault,
+// AWS Secrets Manager or a custom OIDC exchange. A zero expiry means the token
+// does not expire.
+type CredentialProvider interface {
+	Token(ctx context.Context) (token string, expiry time.Time, err error)
+}
+
+// NewCredentialProviderClient creates a new client which obtains its token from the given provider.
+// The token is cached until it expires and then requested again from the provider.
+func NewCredentialProviderClient(p CredentialProvider, userAgent string) (*Client, error) {
+	httpClient, err := buildHttpClient()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Client{
+		Client:             httpClient,
+		UserAgent:          GetUserAgentOrDefault(userAgent),
+		CredentialProvider: p,
+	}
+	c.Init()
+
+	if _, err := c.token(); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// token returns the API token to authorize requests with, refreshing it from
+// the credential provider when it is missing or about to expire
+func (c *Client) token() (string, error) {
+	if c.CredentialProvider == nil {
+		return c.APIKey, nil
+	}
+
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.APIKey != "" && (c.tokenExpiry.IsZero() || time.Now().Add(tokenExpiryMargin).Before(c.tokenExpiry)) {
+		return c.APIKey, nil
+	}
+
+	token, expiry, err := c.CredentialProvider.Token(c.context())
+	if err != nil {
+		return "", err
+	}
+
+	c.APIKey = token
+	c.tokenExpiry = expiry
+
+	return c.APIKey, nil
+}
+
+// invalidateToken drops the token rejected by Aiven so that the next request obtains a new
+// one from the credential provider. A token refreshed meanwhile by another request is kept.
+func (c *Client) invalidateToken(rejected string) {
+	c.tokenMu.Lock()
+	defer c.tokenMu.Unlock()
+
+	if c.APIKey == rejected {
+		c.APIKey = ""
+	}
+}
+
+// userCredentialProvider obtains tokens by signing in with the email and password of a user
+type userCredentialProvider struct {
+	client   *Client
+	email    string
+	otp      string
+	password string
+}
+
+// Token signs in the user, the token has no known expiry and is refreshed when Aiven rejects it
+func (p *userCredentialProvider) Token(ctx context.Context) (string, time.Time, error) {
+	bts, err := p.client.WithContext(ctx).doPostRequest("/userauth", authRequest{p.email, p.otp, p.password})
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	var r authResponse
+	if err := checkAPIResponse(bts, &r); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return r.Token, time.Time{}, nil
+}
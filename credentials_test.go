@@ -0,0 +1,136 @@
+package aiven
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type testCredentialProvider struct {
+	tokens []string
+	expiry time.Duration
+	calls  int
+}
+
+func (p *testCredentialProvider) Token(_ context.Context) (string, time.Time, error) {
+	token := p.tokens[p.calls]
+	p.calls++
+
+	var expiry time.Time
+	if p.expiry != 0 {
+		expiry = time.Now().Add(p.expiry)
+	}
+	return token, expiry, nil
+}
+
+func TestNewCredentialProviderClient(t *testing.T) {
+	var gotAuth []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = append(gotAuth, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(ProjectListResponse{}); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+	apiurl = ts.URL
+
+	tests := []struct {
+		name      string
+		expiry    time.Duration
+		wantAuth  []string
+		wantCalls int
+	}{
+		{
+			"cached",
+			time.Hour,
+			[]string{"aivenv1 token-1", "aivenv1 token-1"},
+			1,
+		},
+		{
+			"no-expiry",
+			0,
+			[]string{"aivenv1 token-1", "aivenv1 token-1"},
+			1,
+		},
+		{
+			"refreshed",
+			time.Second,
+			[]string{"aivenv1 token-2", "aivenv1 token-3"},
+			3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAuth = nil
+			p := &testCredentialProvider{tokens: []string{"token-1", "token-2", "token-3"}, expiry: tt.expiry}
+
+			c, err := NewCredentialProviderClient(p, "aiven-go-client-test/"+Version())
+			if err != nil {
+				t.Fatalf("NewCredentialProviderClient() error = %v", err)
+			}
+
+			for i := 0; i < 2; i++ {
+				if _, err := c.Projects.List(); err != nil {
+					t.Fatalf("List() error = %v", err)
+				}
+			}
+
+			if len(gotAuth) != len(tt.wantAuth) || gotAuth[0] != tt.wantAuth[0] || gotAuth[1] != tt.wantAuth[1] {
+				t.Errorf("Authorization headers = %v, want %v", gotAuth, tt.wantAuth)
+			}
+			if p.calls != tt.wantCalls {
+				t.Errorf("provider calls = %d, want %d", p.calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClient_reauthenticate(t *testing.T) {
+	var valid string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Authorization") != "aivenv1 "+valid {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message": "Invalid token"}`))
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+		if err := json.NewEncoder(w).Encode(ProjectListResponse{}); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+	apiurl = ts.URL
+
+	tests := []struct {
+		name      string
+		valid     string
+		wantErr   bool
+		wantCalls int
+	}{
+		{"expired", "token-2", false, 2},
+		{"rejected", "token-3", true, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &testCredentialProvider{tokens: []string{"token-1", "token-2", "token-3"}}
+			c := &Client{Client: &http.Client{}, CredentialProvider: p}
+			c.Init()
+			valid = tt.valid
+
+			if _, err := c.Projects.List(); (err != nil) != tt.wantErr {
+				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if p.calls != tt.wantCalls {
+				t.Errorf("provider calls = %d, want %d", p.calls, tt.wantCalls)
+			}
+		})
+	}
+}
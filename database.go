@@ -83,6 +83,29 @@ func (h *DatabasesHandler) Delete(project, service, database string) error {
 	return checkAPIResponse(bts, nil)
 }
 
+// DeleteWithPools removes the connection pools referencing the specified database
+// and then the database itself. It returns the connection pools which were removed.
+func (h *DatabasesHandler) DeleteWithPools(project, service, database string) ([]*ConnectionPool, error) {
+	pools, err := h.client.ConnectionPools.List(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []*ConnectionPool
+	for _, pool := range pools {
+		if pool.Database != database {
+			continue
+		}
+
+		if err := h.client.ConnectionPools.Delete(project, service, pool.PoolName); err != nil && !IsNotFound(err) {
+			return removed, err
+		}
+		removed = append(removed, pool)
+	}
+
+	return removed, h.Delete(project, service, database)
+}
+
 // List will return all the databases for a given service.
 func (h *DatabasesHandler) List(project, service string) ([]*Database, error) {
 	path := buildPath("project", project, "service", service, "db")
@@ -67,6 +67,29 @@ func (h *ElasticSearchACLsHandler) Get(project, service string) (*ElasticSearchA
 	return &r, errR
 }
 
+// DeleteByUsername removes all Elasticsearch ACL rules of the given username
+func (h *ElasticSearchACLsHandler) DeleteByUsername(project, service, username string) (*ElasticSearchACLResponse, error) {
+	r, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := r.ElasticSearchACLConfig
+	acls := conf.ACLs[:0]
+	for _, acl := range conf.ACLs {
+		if acl.Username != username {
+			acls = append(acls, acl)
+		}
+	}
+
+	if len(acls) == len(conf.ACLs) {
+		return r, nil
+	}
+	conf.ACLs = acls
+
+	return h.Update(project, service, ElasticsearchACLRequest{ElasticSearchACLConfig: conf})
+}
+
 // Delete subtracts ACL from already existing Elasticsearch ACLs config
 func (conf *ElasticSearchACLConfig) Delete(acl ElasticSearchACL) *ElasticSearchACLConfig {
 	for p, existingAcl := range conf.ACLs { // subtract ALC from existing ACLs config entry that supposed to be deleted
@@ -208,6 +208,24 @@ func TestElasticSearchACLsHandler_Get(t *testing.T) {
 	}
 }
 
+func TestElasticSearchACLsHandler_DeleteByUsername(t *testing.T) {
+	c, tearDown := setupElasticsearchACLsTestCase(t)
+	defer tearDown(t)
+
+	for _, username := range []string{"test-user", "unknown-user"} {
+		t.Run(username, func(t *testing.T) {
+			got, err := c.ElasticsearchACLs.DeleteByUsername("test-pr", "test-sr", username)
+			if err != nil {
+				t.Errorf("DeleteByUsername() error = %v", err)
+				return
+			}
+			if !got.ElasticSearchACLConfig.Enabled {
+				t.Errorf("DeleteByUsername() got = %v, want enabled ACLs config", got)
+			}
+		})
+	}
+}
+
 func TestElasticSearchACLConfig_Add(t *testing.T) {
 	type fields struct {
 		ACLs        []ElasticSearchACL
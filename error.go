@@ -3,34 +3,110 @@
 package aiven
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 )
 
 // Error represents an Aiven API Error.
 type Error struct {
-	Message  string `json:"message"`
-	MoreInfo string `json:"more_info"`
-	Status   int    `json:"status"`
+	Message  string  `json:"message"`
+	MoreInfo string  `json:"more_info"`
+	Status   int     `json:"status"`
+	Errors   []Error `json:"errors,omitempty"`
+
+	// RequestID is the ID Aiven gave to the failed request, to refer to it e.g. in support tickets
+	RequestID string `json:"-"`
 }
 
-// Error concatenates the Status, Message and MoreInfo values.
+// Error concatenates the Status, Message, MoreInfo and RequestID values.
 func (e Error) Error() string {
-	return fmt.Sprintf("%d: %s - %s", e.Status, e.Message, e.MoreInfo)
+	msg := fmt.Sprintf("%d: %s", e.Status, e.Message)
+	if e.MoreInfo != "" {
+		msg += " - " + e.MoreInfo
+	}
+	if e.RequestID != "" {
+		msg += " (request " + e.RequestID + ")"
+	}
+	return msg
 }
 
-// IsNotFound returns true if the specified error has status 404
-func IsNotFound(err error) bool {
-	if e, ok := err.(Error); ok && e.Status == 404 {
+// newError builds the error of a failed request from the response body. Aiven returns a
+// message along with a list of errors, MoreInfo is taken from the first of them when the
+// body has none. A body which isn't JSON becomes the message as is.
+func newError(body []byte, status int) Error {
+	var e Error
+	if err := json.Unmarshal(body, &e); err != nil || (e.Message == "" && len(e.Errors) == 0) {
+		return Error{Message: string(body), Status: status}
+	}
+
+	if e.Message == "" {
+		e.Message = e.Errors[0].Message
+	}
+	if e.MoreInfo == "" && len(e.Errors) > 0 {
+		e.MoreInfo = e.Errors[0].MoreInfo
+	}
+	e.Status = status
+
+	return e
+}
+
+// notFoundMoreInfo are the more_info strings Aiven gives to not found errors which don't
+// have status 404, e.g. a missing project is reported as forbidden
+var notFoundMoreInfo = []string{"not found", "does not exist"}
+
+// IsNotFound returns true if the error has status 404 or a not found more_info, either
+// itself or one of its errors
+func (e Error) IsNotFound() bool {
+	if e.Status == 404 || isNotFoundMoreInfo(e.MoreInfo) {
 		return true
 	}
 
+	for _, sub := range e.Errors {
+		if sub.Status == 404 || isNotFoundMoreInfo(sub.MoreInfo) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNotFoundMoreInfo(moreInfo string) bool {
+	moreInfo = strings.ToLower(moreInfo)
+	for _, s := range notFoundMoreInfo {
+		if strings.Contains(moreInfo, s) {
+			return true
+		}
+	}
+
 	return false
 }
 
+// IsRetryable returns true if the request may succeed when retried, i.e. it timed out, was
+// rate limited or failed on the server side. Other client errors never succeed on retry.
+func (e Error) IsRetryable() bool {
+	return e.Status == 408 || e.Status == 429 || e.Status >= 500
+}
+
+// IsNotFound returns true if the specified error, or an error it wraps, is a not found
+// Aiven API error, see Error.IsNotFound
+func IsNotFound(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.IsNotFound()
+}
+
+// IsRetryable returns true if the specified error, or an error it wraps, is an Aiven API
+// error which may succeed when the request is retried
+func IsRetryable(err error) bool {
+	var e Error
+	return errors.As(err, &e) && e.IsRetryable()
+}
+
 // IsAlreadyExists returns true if the error message and error code that indicates that entity already exists
 func IsAlreadyExists(err error) bool {
-	if e, ok := err.(Error); ok {
+	var e Error
+	if errors.As(err, &e) {
 		if strings.Contains(e.Message, "already exists") && e.Status == 409 {
 			return true
 		}
@@ -0,0 +1,111 @@
+package aiven
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func Test_newError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want Error
+	}{
+		{
+			"structured",
+			`{"errors": [{"message": "Service name already exists", "more_info": "https://api.aiven.io/doc/", "status": 409}],
+			  "message": "Service name already exists"}`,
+			Error{
+				Message:  "Service name already exists",
+				MoreInfo: "https://api.aiven.io/doc/",
+				Status:   409,
+				Errors: []Error{{
+					Message:  "Service name already exists",
+					MoreInfo: "https://api.aiven.io/doc/",
+					Status:   409,
+				}},
+			},
+		},
+		{
+			"message-only",
+			`{"message": "Not found"}`,
+			Error{Message: "Not found", Status: 409},
+		},
+		{
+			"not-json",
+			`<html>Bad gateway</html>`,
+			Error{Message: "<html>Bad gateway</html>", Status: 409},
+		},
+		{
+			"unrelated-json",
+			`{"foo": "bar"}`,
+			Error{Message: `{"foo": "bar"}`, Status: 409},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newError([]byte(tt.body), 409); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("newError() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	if got := (Error{Message: "Not found", Status: 404}).Error(); got != "404: Not found" {
+		t.Errorf("Error() = %q", got)
+	}
+
+	e := Error{Message: "Service name already exists", MoreInfo: "https://api.aiven.io/doc/", Status: 409}
+	if got := e.Error(); got != "409: Service name already exists - https://api.aiven.io/doc/" {
+		t.Errorf("Error() = %q", got)
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"not-found", Error{Message: "Service not found", Status: 404}, true},
+		{"wrapped", fmt.Errorf("cannot get service: %w", Error{Status: 404}), true},
+		{"sub-error", Error{Status: 400, Errors: []Error{{Status: 404}}}, true},
+		{"more-info", Error{Status: 403, MoreInfo: "Project does not exist"}, true},
+		{"sub-error-more-info", Error{Status: 400, Errors: []Error{{Status: 400, MoreInfo: "User not found"}}}, true},
+		{"doc-more-info", Error{Status: 403, MoreInfo: "https://api.aiven.io/doc/"}, false},
+		{"conflict", Error{Status: 409}, false},
+		{"other", errors.New("404"), false},
+		{"nil", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"timeout", Error{Status: 408}, true},
+		{"rate-limited", Error{Status: 429}, true},
+		{"server-error", fmt.Errorf("wrapped: %w", Error{Status: 503}), true},
+		{"bad-request", Error{Status: 400}, false},
+		{"other", errors.New("connection refused"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Errorf("IsRetryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
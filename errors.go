@@ -10,4 +10,8 @@ var (
 
 	// ErrInvalidHost is used when the provided host is formatted incorrectly.
 	ErrInvalidHost = errors.New("host wasn't specified in the correct format: `hostname:port`")
+
+	// ErrEndpointInUse is used when an integration endpoint can't be deleted because service
+	// integrations use it, see EndpointInUseError.
+	ErrEndpointInUse = errors.New("integration endpoint is in use")
 )
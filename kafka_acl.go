@@ -20,6 +20,12 @@ type (
 		Username   string `json:"username"`
 	}
 
+	// KafkaACLRule is a permission of a user on the topics matching the topic pattern
+	KafkaACLRule struct {
+		Permission string
+		Topic      string
+	}
+
 	// KafkaACLResponse represents the response from Aiven after interacting with
 	// the Kafka ACL API.
 	KafkaACLResponse struct {
@@ -98,3 +104,24 @@ func (h *KafkaACLHandler) Delete(project, serviceName, aclID string) error {
 
 	return checkAPIResponse(bts, nil)
 }
+
+// DeleteByUsername deletes all Kafka ACL entries of the given username, e.g. after the
+// service user has been removed. Entries with username patterns matching it are kept.
+func (h *KafkaACLHandler) DeleteByUsername(project, serviceName, username string) error {
+	acls, err := h.List(project, serviceName)
+	if err != nil {
+		return err
+	}
+
+	for _, acl := range acls {
+		if acl.Username != username {
+			continue
+		}
+
+		if err := h.Delete(project, serviceName, acl.ID); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
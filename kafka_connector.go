@@ -3,6 +3,7 @@ package aiven
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 type (
@@ -152,6 +153,37 @@ func (h *KafkaConnectorsHandler) Status(project, service, name string) (*KafkaCo
 	return &rsp, nil
 }
 
+// Pause pauses a Kafka Connector and its tasks by name
+func (h *KafkaConnectorsHandler) Pause(project, service, name string) error {
+	return h.connectorOperation(buildPath("project", project, "service", service, "connectors", name, "pause"))
+}
+
+// Resume resumes a paused Kafka Connector by name
+func (h *KafkaConnectorsHandler) Resume(project, service, name string) error {
+	return h.connectorOperation(buildPath("project", project, "service", service, "connectors", name, "resume"))
+}
+
+// Restart restarts a Kafka Connector by name, its tasks are not restarted
+func (h *KafkaConnectorsHandler) Restart(project, service, name string) error {
+	return h.connectorOperation(buildPath("project", project, "service", service, "connectors", name, "restart"))
+}
+
+// RestartTask restarts a single task of a Kafka Connector, e.g. a failed one reported by Status
+func (h *KafkaConnectorsHandler) RestartTask(project, service, name string, taskID int) error {
+	return h.connectorOperation(
+		buildPath("project", project, "service", service, "connectors", name, "tasks", strconv.Itoa(taskID), "restart"),
+	)
+}
+
+func (h *KafkaConnectorsHandler) connectorOperation(path string) error {
+	bts, err := h.client.doPostRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
+
 // Update updates a Kafka Connector configuration by Connector Name
 func (h *KafkaConnectorsHandler) Update(project, service, name string, c KafkaConnectorConfig) (*KafkaConnectorResponse, error) {
 	path := buildPath("project", project, "service", service, "connectors", name)
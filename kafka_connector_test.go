@@ -88,6 +88,20 @@ func setupKafkaConnectorsTestCase(t *testing.T) (*Client, func(t *testing.T)) {
 			}
 		}
 
+		switch r.URL.Path {
+		case "/project/test-pr/service/test-sr/connectors/test-kafka-con/pause",
+			"/project/test-pr/service/test-sr/connectors/test-kafka-con/resume",
+			"/project/test-pr/service/test-sr/connectors/test-kafka-con/restart",
+			"/project/test-pr/service/test-sr/connectors/test-kafka-con/tasks/0/restart":
+			if r.Method != "POST" {
+				t.Errorf("unexpected method %s for %s", r.Method, r.URL.Path)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{}`))
+			return
+		}
+
 		if r.URL.Path == "/project/test-pr/service/test-sr/connectors/test-kafka-con/status" {
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
@@ -472,3 +486,27 @@ func TestKafkaConnectorsHandler_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaConnectorsHandler_Operations(t *testing.T) {
+	c, tearDown := setupKafkaConnectorsTestCase(t)
+	defer tearDown(t)
+
+	tests := []struct {
+		name string
+		fn   func(project, service, name string) error
+	}{
+		{"pause", c.KafkaConnectors.Pause},
+		{"resume", c.KafkaConnectors.Resume},
+		{"restart", c.KafkaConnectors.Restart},
+		{"restart-task", func(project, service, name string) error {
+			return c.KafkaConnectors.RestartTask(project, service, name, 0)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.fn("test-pr", "test-sr", "test-kafka-con"); err != nil {
+				t.Errorf("%s error = %v", tt.name, err)
+			}
+		})
+	}
+}
@@ -2,9 +2,22 @@ package aiven
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
 )
 
+// KafkaSchemaVersionLatest refers to the latest version of a subject
+const KafkaSchemaVersionLatest = "latest"
+
+const (
+	// KafkaSchemaModeReadWrite allows registering new schemas, this is the default
+	KafkaSchemaModeReadWrite = "READWRITE"
+	// KafkaSchemaModeReadOnly rejects registering new schemas
+	KafkaSchemaModeReadOnly = "READONLY"
+	// KafkaSchemaModeImport allows registering schemas with given IDs, e.g. when migrating a registry
+	KafkaSchemaModeImport = "IMPORT"
+)
+
 type (
 	// KafkaSubjectSchemasHandler is the client which interacts with the Kafka Schema endpoints on Aiven
 	KafkaSubjectSchemasHandler struct {
@@ -33,6 +46,18 @@ type (
 		CompatibilityLevel string `json:"compatibilityLevel"`
 	}
 
+	// KafkaSchemaMode represents the mode of the Schema Registry or of a subject,
+	// one of the KafkaSchemaMode constants
+	KafkaSchemaMode struct {
+		Mode string `json:"mode"`
+	}
+
+	// KafkaSchemaModeResponse represents the response from Aiven Kafka Schema Mode endpoint
+	KafkaSchemaModeResponse struct {
+		APIResponse
+		KafkaSchemaMode
+	}
+
 	// KafkaSchemaSubjects represents a list of Aiven Kafka Schema subjects
 	KafkaSchemaSubjects struct {
 		Subjects []string `json:"subjects"`
@@ -83,11 +108,15 @@ type (
 	// KafkaSchemaValidateResponse Kafka Schemas Subject validation API endpoint response representation
 	KafkaSchemaValidateResponse struct {
 		APIResponse
-		IsCompatible bool `json:"is_compatible"`
+		IsCompatible bool     `json:"is_compatible"`
+		Messages     []string `json:"messages,omitempty"`
 	}
 )
 
-// Update updates new Kafka Schema config entry
+// Update updates new Kafka Schema config entry. The compatibility level can't be given when
+// creating a Kafka service, schema_registry_config in its user config only configures the
+// registry itself. It is set with Update once the service is running with Schema Registry
+// enabled, until then the registry applies its default level BACKWARD.
 func (h *KafkaGlobalSchemaConfigHandler) Update(project, service string, c KafkaSchemaConfig) (*KafkaSchemaConfigUpdateResponse, error) {
 	path := buildPath("project", project, "service", service, "kafka", "schema", "config")
 	bts, err := h.client.doPutRequest(path, c)
@@ -115,6 +144,16 @@ func (h *KafkaGlobalSchemaConfigHandler) Get(project, service string) (*KafkaSch
 	return &r, errR
 }
 
+// GetMode gets the global Schema Registry mode
+func (h *KafkaGlobalSchemaConfigHandler) GetMode(project, service string) (*KafkaSchemaModeResponse, error) {
+	return getKafkaSchemaMode(h.client, buildPath("project", project, "service", service, "kafka", "schema", "mode"))
+}
+
+// SetMode sets the global Schema Registry mode, see the KafkaSchemaMode constants
+func (h *KafkaGlobalSchemaConfigHandler) SetMode(project, service, mode string) (*KafkaSchemaModeResponse, error) {
+	return setKafkaSchemaMode(h.client, buildPath("project", project, "service", service, "kafka", "schema", "mode"), mode)
+}
+
 // List gets a list of Kafka Schema Subjects configuration
 func (h *KafkaSubjectSchemasHandler) List(project, service string) (*KafkaSchemaSubjectsResponse, error) {
 	path := buildPath("project", project, "service", service, "kafka", "schema", "subjects")
@@ -129,6 +168,17 @@ func (h *KafkaSubjectSchemasHandler) List(project, service string) (*KafkaSchema
 	return &r, errR
 }
 
+// ListSubjects returns the names of all Kafka Schema Subjects of the service, like List
+// without the response wrapper
+func (h *KafkaSubjectSchemasHandler) ListSubjects(project, service string) ([]string, error) {
+	r, err := h.List(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Subjects, nil
+}
+
 // GetVersions gets a Kafka Schema Subject versions
 func (h *KafkaSubjectSchemasHandler) GetVersions(project, service, name string) (*KafkaSchemaSubjectVersionsResponse, error) {
 	path := buildPath("project", project, "service", service, "kafka", "schema", "subjects", name, "versions")
@@ -204,6 +254,32 @@ func (h *KafkaSubjectSchemasHandler) Validate(
 	return r.IsCompatible, errR
 }
 
+// CheckCompatibility checks whether the schema is compatible with the given version of the
+// subject, which is either a version number or KafkaSchemaVersionLatest. The messages explain
+// why the schema is incompatible.
+func (h *KafkaSubjectSchemasHandler) CheckCompatibility(
+	project, service, subject, version, schema string,
+) (bool, []string, error) {
+	if version != KafkaSchemaVersionLatest {
+		if v, err := strconv.Atoi(version); err != nil || v < 1 {
+			return false, nil, fmt.Errorf("invalid schema version %q, must be a positive number or %q", version, KafkaSchemaVersionLatest)
+		}
+	}
+
+	path := buildPath("project", project, "service", service, "kafka", "schema", "compatibility", "subjects", subject, "versions", version)
+	bts, err := h.client.doPostRequest(path, KafkaSchemaSubject{Schema: schema})
+	if err != nil {
+		return false, nil, err
+	}
+
+	var r KafkaSchemaValidateResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return false, nil, errR
+	}
+
+	return r.IsCompatible, r.Messages, nil
+}
+
 // Add adds a new kafka Schema
 func (h *KafkaSubjectSchemasHandler) Add(project, service, name string, subject KafkaSchemaSubject) (*KafkaSchemaSubjectResponse, error) {
 	vR, err := h.GetVersions(project, service, name)
@@ -277,3 +353,45 @@ func (h *KafkaSubjectSchemasHandler) GetConfiguration(project, service, subjectN
 
 	return &r, errR
 }
+
+// GetMode gets the Schema Registry mode of a subject
+func (h *KafkaSubjectSchemasHandler) GetMode(project, service, subjectName string) (*KafkaSchemaModeResponse, error) {
+	return getKafkaSchemaMode(h.client, buildPath("project", project, "service", service, "kafka", "schema", "mode", subjectName))
+}
+
+// SetMode sets the Schema Registry mode of a subject, see the KafkaSchemaMode constants
+func (h *KafkaSubjectSchemasHandler) SetMode(project, service, subjectName, mode string) (*KafkaSchemaModeResponse, error) {
+	return setKafkaSchemaMode(
+		h.client,
+		buildPath("project", project, "service", service, "kafka", "schema", "mode", subjectName),
+		mode,
+	)
+}
+
+func getKafkaSchemaMode(client *Client, path string) (*KafkaSchemaModeResponse, error) {
+	bts, err := client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r KafkaSchemaModeResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return &r, errR
+}
+
+func setKafkaSchemaMode(client *Client, path, mode string) (*KafkaSchemaModeResponse, error) {
+	if mode != KafkaSchemaModeReadWrite && mode != KafkaSchemaModeReadOnly && mode != KafkaSchemaModeImport {
+		return nil, errors.New("unsupported schema registry mode: " + mode)
+	}
+
+	bts, err := client.doPutRequest(path, KafkaSchemaMode{Mode: mode})
+	if err != nil {
+		return nil, err
+	}
+
+	var r KafkaSchemaModeResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return &r, errR
+}
@@ -78,6 +78,30 @@ func setupKafkaSchemasTestCase(t *testing.T) (*Client, func(t *testing.T)) {
 			return
 		}
 
+		// mode
+		if r.URL.Path == "/project/test-pr/service/test-sr/kafka/schema/mode" {
+			mode := KafkaSchemaModeReadWrite
+			if r.Method == "PUT" {
+				var req KafkaSchemaMode
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Error(err)
+				}
+				mode = req.Mode
+			}
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(KafkaSchemaModeResponse{
+				KafkaSchemaMode: KafkaSchemaMode{Mode: mode},
+			})
+
+			if err != nil {
+				t.Error(err)
+			}
+
+			return
+		}
+
 		// subjects
 		if r.URL.Path == "/project/test-pr/service/test-sr/kafka/schema/subjects" {
 			w.Header().Set("Content-Type", "application/json")
@@ -155,13 +179,29 @@ func setupKafkaSchemasTestCase(t *testing.T) (*Client, func(t *testing.T)) {
 			}
 		}
 
+		// validate against the latest version
+		if r.URL.Path == "/project/test-pr/service/test-sr/kafka/schema/compatibility/subjects/test-schema/versions/latest" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(KafkaSchemaValidateResponse{
+				IsCompatible: false,
+				Messages:     []string{"reader field test has no default value"},
+			})
+
+			if err != nil {
+				t.Error(err)
+			}
+
+			return
+		}
+
 		// validate against version 4
 		if r.URL.Path == "/project/test-pr/service/test-sr/kafka/schema/compatibility/subjects/test-schema/versions/4" {
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(http.StatusOK)
 			err := json.NewEncoder(w).Encode(KafkaSchemaValidateResponse{
-				APIResponse{},
-				true,
+				APIResponse:  APIResponse{},
+				IsCompatible: true,
 			})
 
 			if err != nil {
@@ -676,3 +716,54 @@ func TestKafkaSchemaHandler_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestKafkaGlobalSchemaConfigHandler_Mode(t *testing.T) {
+	c, tearDown := setupKafkaSchemasTestCase(t)
+	defer tearDown(t)
+
+	got, err := c.KafkaGlobalSchemaConfig.GetMode("test-pr", "test-sr")
+	if err != nil || got.Mode != KafkaSchemaModeReadWrite {
+		t.Errorf("GetMode() = %v, %v, want %v", got, err, KafkaSchemaModeReadWrite)
+	}
+
+	got, err = c.KafkaGlobalSchemaConfig.SetMode("test-pr", "test-sr", KafkaSchemaModeImport)
+	if err != nil || got.Mode != KafkaSchemaModeImport {
+		t.Errorf("SetMode() = %v, %v, want %v", got, err, KafkaSchemaModeImport)
+	}
+
+	if _, err = c.KafkaGlobalSchemaConfig.SetMode("test-pr", "test-sr", "WRITEONLY"); err == nil {
+		t.Error("SetMode() expected an error for an unsupported mode")
+	}
+}
+
+func TestKafkaSchemaHandler_CheckCompatibility(t *testing.T) {
+	c, tearDown := setupKafkaSchemasTestCase(t)
+	defer tearDown(t)
+
+	schema := `{"type": "record", "name": "example", "fields": [{"name": "test", "type": "int"}]}`
+
+	tests := []struct {
+		name         string
+		version      string
+		want         bool
+		wantMessages int
+		wantErr      bool
+	}{
+		{"version", "4", true, 0, false},
+		{"latest", KafkaSchemaVersionLatest, false, 1, false},
+		{"invalid-version", "first", false, 0, true},
+		{"zero-version", "0", false, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, messages, err := c.KafkaSubjectSchemas.CheckCompatibility("test-pr", "test-sr", "test-schema", tt.version, schema)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CheckCompatibility() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want || len(messages) != tt.wantMessages {
+				t.Errorf("CheckCompatibility() got = %v, %v, want %v with %d messages", got, messages, tt.want, tt.wantMessages)
+			}
+		})
+	}
+}
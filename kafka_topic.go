@@ -3,6 +3,8 @@
 
 package aiven
 
+import "fmt"
+
 type (
 	// KafkaTopicConfig represents a Kafka Topic Config on Aiven.
 	KafkaTopicConfig struct {
@@ -13,16 +15,21 @@ type (
 		FlushMessages                   *int64   `json:"flush_messages,omitempty"`
 		FlushMs                         *int64   `json:"flush_ms,omitempty"`
 		IndexIntervalBytes              *int64   `json:"index_interval_bytes,omitempty"`
+		LocalRetentionBytes             *int64   `json:"local_retention_bytes,omitempty"`
+		LocalRetentionMs                *int64   `json:"local_retention_ms,omitempty"`
 		MaxCompactionLagMs              *int64   `json:"max_compaction_lag_ms,omitempty"`
 		MaxMessageBytes                 *int64   `json:"max_message_bytes,omitempty"`
 		MessageDownconversionEnable     *bool    `json:"message_downconversion_enable,omitempty"`
 		MessageFormatVersion            string   `json:"message_format_version,omitempty"`
+		MessageTimestampAfterMaxMs      *int64   `json:"message_timestamp_after_max_ms,omitempty"`
+		MessageTimestampBeforeMaxMs     *int64   `json:"message_timestamp_before_max_ms,omitempty"`
 		MessageTimestampDifferenceMaxMs *int64   `json:"message_timestamp_difference_max_ms,omitempty"`
 		MessageTimestampType            string   `json:"message_timestamp_type,omitempty"`
 		MinCleanableDirtyRatio          *float64 `json:"min_cleanable_dirty_ratio,omitempty"`
 		MinCompactionLagMs              *int64   `json:"min_compaction_lag_ms,omitempty"`
 		MinInsyncReplicas               *int64   `json:"min_insync_replicas,omitempty"`
 		Preallocate                     *bool    `json:"preallocate,omitempty"`
+		RemoteStorageEnable             *bool    `json:"remote_storage_enable,omitempty"`
 		RetentionBytes                  *int64   `json:"retention_bytes,omitempty"`
 		RetentionMs                     *int64   `json:"retention_ms,omitempty"`
 		SegmentBytes                    *int64   `json:"segment_bytes,omitempty"`
@@ -41,16 +48,21 @@ type (
 		FlushMessages                   KafkaTopicConfigResponseInt    `json:"flush_messages,omitempty"`
 		FlushMs                         KafkaTopicConfigResponseInt    `json:"flush_ms,omitempty"`
 		IndexIntervalBytes              KafkaTopicConfigResponseInt    `json:"index_interval_bytes,omitempty"`
+		LocalRetentionBytes             KafkaTopicConfigResponseInt    `json:"local_retention_bytes,omitempty"`
+		LocalRetentionMs                KafkaTopicConfigResponseInt    `json:"local_retention_ms,omitempty"`
 		MaxCompactionLagMs              KafkaTopicConfigResponseInt    `json:"max_compaction_lag_ms,omitempty"`
 		MaxMessageBytes                 KafkaTopicConfigResponseInt    `json:"max_message_bytes,omitempty"`
 		MessageDownconversionEnable     KafkaTopicConfigResponseBool   `json:"message_downconversion_enable,omitempty"`
 		MessageFormatVersion            KafkaTopicConfigResponseString `json:"message_format_version,omitempty"`
+		MessageTimestampAfterMaxMs      KafkaTopicConfigResponseInt    `json:"message_timestamp_after_max_ms,omitempty"`
+		MessageTimestampBeforeMaxMs     KafkaTopicConfigResponseInt    `json:"message_timestamp_before_max_ms,omitempty"`
 		MessageTimestampDifferenceMaxMs KafkaTopicConfigResponseInt    `json:"message_timestamp_difference_max_ms,omitempty"`
 		MessageTimestampType            KafkaTopicConfigResponseString `json:"message_timestamp_type,omitempty"`
 		MinCleanableDirtyRatio          KafkaTopicConfigResponseFloat  `json:"min_cleanable_dirty_ratio,omitempty"`
 		MinCompactionLagMs              KafkaTopicConfigResponseInt    `json:"min_compaction_lag_ms,omitempty"`
 		MinInsyncReplicas               KafkaTopicConfigResponseInt    `json:"min_insync_replicas,omitempty"`
 		Preallocate                     KafkaTopicConfigResponseBool   `json:"preallocate,omitempty"`
+		RemoteStorageEnable             KafkaTopicConfigResponseBool   `json:"remote_storage_enable,omitempty"`
 		RetentionBytes                  KafkaTopicConfigResponseInt    `json:"retention_bytes,omitempty"`
 		RetentionMs                     KafkaTopicConfigResponseInt    `json:"retention_ms,omitempty"`
 		SegmentBytes                    KafkaTopicConfigResponseInt    `json:"segment_bytes,omitempty"`
@@ -61,6 +73,7 @@ type (
 		Tags                            []KafkaTopicTag                `json:"tags,omitempty"`
 	}
 
+	// KafkaTopicTag represents a key/value tag attached to a Kafka Topic.
 	KafkaTopicTag struct {
 		Key   string `json:"key"`
 		Value string `json:"value"`
@@ -122,14 +135,15 @@ type (
 
 	// KafkaListTopic represents kafka list topic model on Aiven.
 	KafkaListTopic struct {
-		CleanupPolicy         string `json:"cleanup_policy"`
-		MinimumInSyncReplicas int    `json:"min_insync_replicas"`
-		Partitions            int    `json:"partitions"`
-		Replication           int    `json:"replication"`
-		RetentionBytes        int    `json:"retention_bytes"`
-		RetentionHours        *int64 `json:"retention_hours,omitempty"`
-		State                 string `json:"state"`
-		TopicName             string `json:"topic_name"`
+		CleanupPolicy         string          `json:"cleanup_policy"`
+		MinimumInSyncReplicas int             `json:"min_insync_replicas"`
+		Partitions            int             `json:"partitions"`
+		Replication           int             `json:"replication"`
+		RetentionBytes        int             `json:"retention_bytes"`
+		RetentionHours        *int64          `json:"retention_hours,omitempty"`
+		State                 string          `json:"state"`
+		TopicName             string          `json:"topic_name"`
+		Tags                  []KafkaTopicTag `json:"tags,omitempty"`
 	}
 
 	// Partition represents a Kafka partition.
@@ -199,6 +213,10 @@ type (
 
 // Create creats a specific kafka topic.
 func (h *KafkaTopicsHandler) Create(project, service string, req CreateKafkaTopicRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
 	path := buildPath("project", project, "service", service, "topic")
 	bts, err := h.client.doPostRequest(path, req)
 	if err != nil {
@@ -222,7 +240,22 @@ func (h *KafkaTopicsHandler) Get(project, service, topic string) (*KafkaTopic, e
 	return r.Topic, errR
 }
 
-// List lists all the kafka topics.
+// Exists checks whether the topic exists. It gets the single topic rather than listing all
+// topics of the service, a missing topic or service is not an error.
+func (h *KafkaTopicsHandler) Exists(project, service, topic string) (bool, error) {
+	if _, err := h.Get(project, service, topic); err != nil {
+		if IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+// List lists all the kafka topics. It is the lightweight listing, returning the topic names
+// and partition and replication counts without the topic configs, which can be fetched for
+// selected topics with Get or V2List.
 func (h *KafkaTopicsHandler) List(project, service string) ([]*KafkaListTopic, error) {
 	path := buildPath("project", project, "service", service, "topic")
 	bts, err := h.client.doGetRequest(path, nil)
@@ -238,6 +271,10 @@ func (h *KafkaTopicsHandler) List(project, service string) ([]*KafkaListTopic, e
 
 // Update updates a specific topic with the given parameters.
 func (h *KafkaTopicsHandler) Update(project, service, topic string, req UpdateKafkaTopicRequest) error {
+	if err := req.Validate(); err != nil {
+		return err
+	}
+
 	path := buildPath("project", project, "service", service, "topic", topic)
 	bts, err := h.client.doPutRequest(path, req)
 	if err != nil {
@@ -247,6 +284,26 @@ func (h *KafkaTopicsHandler) Update(project, service, topic string, req UpdateKa
 	return checkAPIResponse(bts, nil)
 }
 
+// UpdatePartitions increases the partition count of the topic. Kafka doesn't allow decreasing
+// the partition count, so that is rejected before making the update.
+func (h *KafkaTopicsHandler) UpdatePartitions(project, service, topic string, partitions int) error {
+	t, err := h.Get(project, service, topic)
+	if err != nil {
+		return err
+	}
+
+	current := len(t.Partitions)
+	if partitions < current {
+		return fmt.Errorf("cannot decrease partitions of topic %s from %d to %d", topic, current, partitions)
+	}
+
+	if partitions == current {
+		return nil
+	}
+
+	return h.Update(project, service, topic, UpdateKafkaTopicRequest{Partitions: &partitions})
+}
+
 // Delete deletes a specific kafka topic.
 func (h *KafkaTopicsHandler) Delete(project, service, topic string) error {
 	path := buildPath("project", project, "service", service, "topic", topic)
@@ -258,22 +315,38 @@ func (h *KafkaTopicsHandler) Delete(project, service, topic string) error {
 	return checkAPIResponse(bts, nil)
 }
 
-// V2List lists selected kafka topics using v2 API endpoint.
+// v2ListBatchSize is the number of topics fetched per V2List request, since fetching the
+// configs of thousands of topics at once can exceed request timeouts
+var v2ListBatchSize = 100
+
+// V2List lists selected kafka topics with their configs using v2 API endpoint. The topics
+// are fetched in batches, use List for a lightweight listing of all the topics.
 func (h *KafkaTopicsHandler) V2List(project, service string, topics []string) ([]*KafkaTopic, error) {
 	type v2ListRequest struct {
 		TopicNames []string `json:"topic_names"`
 	}
 
-	req := v2ListRequest{TopicNames: topics}
-
 	path := buildPath("project", project, "service", service, "topic")
-	bts, err := h.client.doV2PostRequest(path, req)
-	if err != nil {
-		return nil, err
-	}
 
-	var r KafkaV2TopicsResponse
-	errR := checkAPIResponse(bts, &r)
+	var result []*KafkaTopic
+	for start := 0; start == 0 || start < len(topics); start += v2ListBatchSize {
+		end := start + v2ListBatchSize
+		if end > len(topics) {
+			end = len(topics)
+		}
 
-	return r.Topics, errR
+		bts, err := h.client.doV2PostRequest(path, v2ListRequest{TopicNames: topics[start:end]})
+		if err != nil {
+			return nil, err
+		}
+
+		var r KafkaV2TopicsResponse
+		if errR := checkAPIResponse(bts, &r); errR != nil {
+			return nil, errR
+		}
+
+		result = append(result, r.Topics...)
+	}
+
+	return result, nil
 }
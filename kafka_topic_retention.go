@@ -0,0 +1,94 @@
+package aiven
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// retentionInfinite disables the time or size based retention of a Kafka topic
+	retentionInfinite = -1
+
+	// localRetentionDefault makes the local retention of a tiered storage topic equal its retention
+	localRetentionDefault = -2
+)
+
+// InfiniteRetention returns the retention_ms or retention_bytes value which disables the
+// time or size based retention of a Kafka topic
+func InfiniteRetention() *int64 {
+	v := int64(retentionInfinite)
+	return &v
+}
+
+// RetentionDays returns the retention_ms value which keeps messages for n days
+func RetentionDays(n int) *int64 {
+	v := int64(n) * 24 * 60 * 60 * 1000
+	return &v
+}
+
+// Validate checks the retention settings of the topic config
+func (c KafkaTopicConfig) Validate() error {
+	if c.RetentionMs != nil && *c.RetentionMs < retentionInfinite {
+		return fmt.Errorf("retention_ms must be -1 (infinite) or non-negative, got %d", *c.RetentionMs)
+	}
+
+	if c.RetentionBytes != nil && *c.RetentionBytes < retentionInfinite {
+		return fmt.Errorf("retention_bytes must be -1 (infinite) or non-negative, got %d", *c.RetentionBytes)
+	}
+
+	if c.LocalRetentionMs != nil && *c.LocalRetentionMs < localRetentionDefault {
+		return fmt.Errorf("local_retention_ms must be -2 (retention_ms) or greater, got %d", *c.LocalRetentionMs)
+	}
+
+	if c.LocalRetentionBytes != nil && *c.LocalRetentionBytes < localRetentionDefault {
+		return fmt.Errorf("local_retention_bytes must be -2 (retention_bytes) or greater, got %d", *c.LocalRetentionBytes)
+	}
+
+	return nil
+}
+
+// RetentionWarning returns a hint when retention_bytes is smaller than segment_bytes, or an
+// empty string otherwise. Kafka accepts such a config, but only deletes closed segments, so a
+// partition never shrinks below its active segment. Validate does not call it.
+func (c KafkaTopicConfig) RetentionWarning() string {
+	if c.RetentionBytes != nil && c.SegmentBytes != nil &&
+		*c.RetentionBytes > 0 && *c.RetentionBytes < *c.SegmentBytes {
+		return fmt.Sprintf(
+			"retention_bytes (%d) is smaller than segment_bytes (%d), partitions keep at least one segment",
+			*c.RetentionBytes, *c.SegmentBytes)
+	}
+
+	return ""
+}
+
+// Validate checks that the retention of the topic is not set both by the deprecated
+// request fields and the topic config, and that the topic config is valid
+func (r CreateKafkaTopicRequest) Validate() error {
+	return validateKafkaTopicRetention(r.RetentionHours, r.RetentionBytes, r.Config)
+}
+
+// Validate checks that the retention of the topic is not set both by the deprecated
+// request fields and the topic config, and that the topic config is valid
+func (r UpdateKafkaTopicRequest) Validate() error {
+	return validateKafkaTopicRetention(r.RetentionHours, r.RetentionBytes, r.Config)
+}
+
+func validateKafkaTopicRetention(retentionHours, retentionBytes *int, c KafkaTopicConfig) error {
+	if retentionHours != nil && c.RetentionMs != nil {
+		return errors.New("retention_hours and config retention_ms are mutually exclusive")
+	}
+
+	if retentionBytes != nil && c.RetentionBytes != nil {
+		return errors.New("retention_bytes and config retention_bytes are mutually exclusive")
+	}
+
+	if retentionHours != nil && *retentionHours < retentionInfinite {
+		return fmt.Errorf("retention_hours must be -1 (infinite) or non-negative, got %d", *retentionHours)
+	}
+
+	if retentionBytes != nil && *retentionBytes < retentionInfinite {
+		return fmt.Errorf("retention_bytes must be -1 (infinite) or non-negative, got %d", *retentionBytes)
+	}
+
+	return c.Validate()
+}
@@ -0,0 +1,105 @@
+package aiven
+
+import "testing"
+
+func TestRetentionDays(t *testing.T) {
+	if got := *RetentionDays(7); got != 604800000 {
+		t.Errorf("RetentionDays(7) = %v, want %v", got, 604800000)
+	}
+
+	if got := *InfiniteRetention(); got != -1 {
+		t.Errorf("InfiniteRetention() = %v, want %v", got, -1)
+	}
+}
+
+func TestCreateKafkaTopicRequest_Validate(t *testing.T) {
+	i := func(v int) *int { return &v }
+	i64 := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name    string
+		req     CreateKafkaTopicRequest
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			req:  CreateKafkaTopicRequest{TopicName: "test-topic"},
+		},
+		{
+			name: "infinite",
+			req: CreateKafkaTopicRequest{
+				Config: KafkaTopicConfig{RetentionMs: InfiniteRetention(), RetentionBytes: InfiniteRetention()},
+			},
+		},
+		{
+			name: "days",
+			req: CreateKafkaTopicRequest{
+				Config: KafkaTopicConfig{RetentionMs: RetentionDays(3), RetentionBytes: i64(1 << 30)},
+			},
+		},
+		{
+			name: "zero retention",
+			req:  CreateKafkaTopicRequest{Config: KafkaTopicConfig{RetentionMs: i64(0), RetentionBytes: i64(0)}},
+		},
+		{
+			name:    "negative retention ms",
+			req:     CreateKafkaTopicRequest{Config: KafkaTopicConfig{RetentionMs: i64(-2)}},
+			wantErr: true,
+		},
+		{
+			name:    "negative retention hours",
+			req:     CreateKafkaTopicRequest{RetentionHours: i(-5)},
+			wantErr: true,
+		},
+		{
+			name: "hours and ms",
+			req: CreateKafkaTopicRequest{
+				RetentionHours: i(24),
+				Config:         KafkaTopicConfig{RetentionMs: RetentionDays(1)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "retention smaller than segment",
+			req: CreateKafkaTopicRequest{
+				Config: KafkaTopicConfig{RetentionBytes: i64(1024), SegmentBytes: i64(1 << 20)},
+			},
+		},
+		{
+			name: "zero retention with segment",
+			req: CreateKafkaTopicRequest{
+				Config: KafkaTopicConfig{RetentionBytes: i64(0), SegmentBytes: i64(1 << 20)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.req.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestKafkaTopicConfig_RetentionWarning(t *testing.T) {
+	i64 := func(v int64) *int64 { return &v }
+
+	tests := []struct {
+		name     string
+		config   KafkaTopicConfig
+		wantWarn bool
+	}{
+		{"empty", KafkaTopicConfig{}, false},
+		{"infinite", KafkaTopicConfig{RetentionBytes: InfiniteRetention(), SegmentBytes: i64(1 << 20)}, false},
+		{"zero", KafkaTopicConfig{RetentionBytes: i64(0), SegmentBytes: i64(1 << 20)}, false},
+		{"larger", KafkaTopicConfig{RetentionBytes: i64(1 << 30), SegmentBytes: i64(1 << 20)}, false},
+		{"smaller", KafkaTopicConfig{RetentionBytes: i64(1024), SegmentBytes: i64(1 << 20)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.config.RetentionWarning(); (got != "") != tt.wantWarn {
+				t.Errorf("RetentionWarning() = %q, wantWarn %v", got, tt.wantWarn)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestKafkaTopicsHandler_UpdatePartitions(t *testing.T) {
+	tests := []struct {
+		name        string
+		partitions  int
+		wantErr     bool
+		wantUpdated bool
+	}{
+		{"increase", 6, false, true},
+		{"unchanged", 3, false, false},
+		{"decrease", 1, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *UpdateKafkaTopicRequest
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == "PUT" {
+					updated = &UpdateKafkaTopicRequest{}
+					if err := json.NewDecoder(r.Body).Decode(updated); err != nil {
+						t.Error(err)
+					}
+					_, _ = w.Write([]byte(`{"message": "updated"}`))
+					return
+				}
+
+				topic := &KafkaTopic{TopicName: "events", Partitions: []*Partition{{}, {}, {}}}
+				if err := json.NewEncoder(w).Encode(KafkaTopicResponse{Topic: topic}); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer ts.Close()
+
+			apiurl = ts.URL
+			c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+			c.Init()
+
+			err := c.KafkaTopics.UpdatePartitions("test-pr", "kafka", "events", tt.partitions)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdatePartitions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if (updated != nil) != tt.wantUpdated {
+				t.Fatalf("UpdatePartitions() updated = %v, wantUpdated %v", updated, tt.wantUpdated)
+			}
+
+			if updated != nil && (updated.Partitions == nil || *updated.Partitions != tt.partitions) {
+				t.Errorf("UpdatePartitions() got partitions = %v, want %v", updated.Partitions, tt.partitions)
+			}
+		})
+	}
+}
+
+func TestKafkaTopicsHandler_V2List(t *testing.T) {
+	batchSize := v2ListBatchSize
+	v2ListBatchSize = 2
+	defer func() { v2ListBatchSize = batchSize }()
+
+	var batches [][]string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			TopicNames []string `json:"topic_names"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		batches = append(batches, req.TopicNames)
+
+		var rsp KafkaV2TopicsResponse
+		for _, name := range req.TopicNames {
+			rsp.Topics = append(rsp.Topics, &KafkaTopic{TopicName: name})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(rsp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	urlV2 := apiurlV2
+	apiurl = ts.URL
+	apiurlV2 = ts.URL
+	defer func() { apiurlV2 = urlV2 }()
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	got, err := c.KafkaTopics.V2List("test-pr", "kafka", []string{"a", "b", "c", "d", "e"})
+	if err != nil {
+		t.Fatalf("V2List() error = %v", err)
+	}
+
+	if len(batches) != 3 || len(batches[2]) != 1 {
+		t.Errorf("V2List() got batches = %v", batches)
+	}
+
+	if len(got) != 5 || got[4].TopicName != "e" {
+		t.Errorf("V2List() got %d topics", len(got))
+	}
+}
+
+func TestKafkaTopicsHandler_Exists(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/project/test-pr/service/kafka/topic/events":
+			err := json.NewEncoder(w).Encode(KafkaTopicResponse{Topic: &KafkaTopic{TopicName: "events"}})
+			if err != nil {
+				t.Error(err)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Service not found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	tests := []struct {
+		name    string
+		service string
+		topic   string
+		want    bool
+	}{
+		{"exists", "kafka", "events", true},
+		{"missing-topic", "kafka", "orders", false},
+		{"missing-service", "other", "events", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.KafkaTopics.Exists("test-pr", tt.service, tt.topic)
+			if err != nil {
+				t.Fatalf("Exists() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Exists() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package aiven
+
+// Logger is used by the client to report non fatal problems and retried requests, messages
+// are prefixed with their level, e.g. [WARNING] or [DEBUG]. It is satisfied by *log.Logger.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// noopLogger discards all messages, it is used when no Logger is configured
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...interface{}) {}
+
+// logger returns the configured Logger or a no-op one
+func (c *Client) logger() Logger {
+	if c.Logger == nil {
+		return noopLogger{}
+	}
+
+	return c.Logger
+}
@@ -0,0 +1,80 @@
+package aiven
+
+import "time"
+
+type (
+	// Me represents the authenticated user
+	Me struct {
+		UserId             string              `json:"user_id"`
+		Email              string              `json:"user"`
+		RealName           string              `json:"real_name"`
+		State              string              `json:"state"`
+		CreateTime         *time.Time          `json:"create_time,omitempty"`
+		Projects           []string            `json:"projects"`
+		ProjectMembership  map[string]string   `json:"project_membership"`
+		ProjectMemberships map[string][]string `json:"project_memberships"`
+	}
+
+	// Organization represents an organization, the top of the account hierarchy
+	Organization struct {
+		Id         string     `json:"organization_id"`
+		Name       string     `json:"organization_name"`
+		AccountId  string     `json:"account_id"`
+		TenantId   string     `json:"tenant_id,omitempty"`
+		CreateTime *time.Time `json:"create_time,omitempty"`
+		UpdateTime *time.Time `json:"update_time,omitempty"`
+	}
+
+	// OrganizationsResponse represents the response from Aiven for the organizations of the authenticated user
+	OrganizationsResponse struct {
+		APIResponse
+		Organizations []Organization `json:"organizations"`
+	}
+
+	// MeResponse represents the response from Aiven for the authenticated user
+	MeResponse struct {
+		APIResponse
+		User Me `json:"user"`
+	}
+)
+
+// Me returns the authenticated user
+func (c *Client) Me() (*Me, error) {
+	bts, err := c.doGetRequest(buildPath("me"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r MeResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	return &r.User, nil
+}
+
+// ListAccessibleOrganizations returns the organizations the authenticated user has access to
+func (c *Client) ListAccessibleOrganizations() ([]Organization, error) {
+	bts, err := c.doGetRequest(buildPath("organizations"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r OrganizationsResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	return r.Organizations, nil
+}
+
+// ListAccessibleAccounts returns the accounts the authenticated user has access to. The
+// organization, parent and root account IDs of each account place it in the hierarchy.
+func (c *Client) ListAccessibleAccounts() ([]Account, error) {
+	r, err := c.Accounts.List()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Accounts, nil
+}
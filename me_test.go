@@ -0,0 +1,72 @@
+package aiven
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func setupMeTestCase(t *testing.T) (*Client, func(t *testing.T)) {
+	t.Log("setup Me test case")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/organizations":
+			_, _ = w.Write([]byte(`{"organizations": [
+				{"organization_id": "org2c5a4d5c", "organization_name": "Acme", "account_id": "a2c5a4d5c"}]}`))
+		case "/account":
+			_, _ = w.Write([]byte(`{"accounts": [
+				{"account_id": "a2c5a4d5c", "account_name": "Acme", "organization_id": "org2c5a4d5c",
+					"root_account_id": "a2c5a4d5c"},
+				{"account_id": "a2c5a4d5d", "account_name": "Acme Dev", "organization_id": "org2c5a4d5c",
+					"parent_account_id": "a2c5a4d5c", "root_account_id": "a2c5a4d5c"}]}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	return c, func(t *testing.T) {
+		t.Log("teardown Me test case")
+		ts.Close()
+	}
+}
+
+func TestClient_ListAccessibleOrganizations(t *testing.T) {
+	c, tearDown := setupMeTestCase(t)
+	defer tearDown(t)
+
+	got, err := c.ListAccessibleOrganizations()
+	if err != nil {
+		t.Fatalf("ListAccessibleOrganizations() error = %v", err)
+	}
+
+	want := []Organization{{Id: "org2c5a4d5c", Name: "Acme", AccountId: "a2c5a4d5c"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListAccessibleOrganizations() got = %v, want %v", got, want)
+	}
+}
+
+func TestClient_ListAccessibleAccounts(t *testing.T) {
+	c, tearDown := setupMeTestCase(t)
+	defer tearDown(t)
+
+	got, err := c.ListAccessibleAccounts()
+	if err != nil {
+		t.Fatalf("ListAccessibleAccounts() error = %v", err)
+	}
+
+	want := []Account{
+		{Id: "a2c5a4d5c", Name: "Acme", OrganizationId: "org2c5a4d5c", RootId: "a2c5a4d5c"},
+		{Id: "a2c5a4d5d", Name: "Acme Dev", OrganizationId: "org2c5a4d5c", ParentId: "a2c5a4d5c", RootId: "a2c5a4d5c"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ListAccessibleAccounts() got = %v, want %v", got, want)
+	}
+}
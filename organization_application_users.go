@@ -0,0 +1,214 @@
+package aiven
+
+import (
+	"errors"
+	"time"
+)
+
+type (
+	// OrganizationApplicationUsersHandler Aiven go-client handler for Organization Application Users
+	OrganizationApplicationUsersHandler struct {
+		client *Client
+	}
+
+	// OrganizationApplicationUser represents a non-human user of an organization
+	OrganizationApplicationUser struct {
+		UserId       string `json:"user_id,omitempty"`
+		Name         string `json:"name"`
+		UserEmail    string `json:"user_email,omitempty"`
+		IsSuperAdmin bool   `json:"is_super_admin"`
+	}
+
+	// OrganizationApplicationUsersResponse represents organization application users list API response
+	OrganizationApplicationUsersResponse struct {
+		APIResponse
+		ApplicationUsers []OrganizationApplicationUser `json:"application_users"`
+	}
+
+	// OrganizationApplicationUserResponse represents a organization application user API response
+	OrganizationApplicationUserResponse struct {
+		APIResponse
+		OrganizationApplicationUser
+	}
+
+	// UpdateOrganizationApplicationUserRequest represents a partial update of an application user,
+	// fields left nil are not changed
+	UpdateOrganizationApplicationUserRequest struct {
+		Name         *string `json:"name,omitempty"`
+		IsSuperAdmin *bool   `json:"is_super_admin,omitempty"`
+	}
+
+	// OrganizationApplicationUserTokenRequest represents a request to create an application user token
+	OrganizationApplicationUserTokenRequest struct {
+		Description    string   `json:"description,omitempty"`
+		MaxAgeSeconds  *int     `json:"max_age_seconds,omitempty"`
+		ExtendWhenUsed bool     `json:"extend_when_used,omitempty"`
+		Scopes         []string `json:"scopes,omitempty"`
+	}
+
+	// OrganizationApplicationUserToken represents an application user access token
+	OrganizationApplicationUserToken struct {
+		TokenPrefix    string     `json:"token_prefix"`
+		Description    string     `json:"description"`
+		CreateTime     *time.Time `json:"create_time,omitempty"`
+		ExpiryTime     *time.Time `json:"expiry_time,omitempty"`
+		LastUsedTime   *time.Time `json:"last_used_time,omitempty"`
+		MaxAgeSeconds  *int       `json:"max_age_seconds,omitempty"`
+		ExtendWhenUsed bool       `json:"extend_when_used"`
+		Scopes         []string   `json:"scopes"`
+	}
+
+	// OrganizationApplicationUserTokenResponse represents a created application user token API response,
+	// the full token is only returned upon creation
+	OrganizationApplicationUserTokenResponse struct {
+		APIResponse
+		TokenPrefix string `json:"token_prefix"`
+		FullToken   string `json:"full_token"`
+	}
+
+	// OrganizationApplicationUserTokensResponse represents application user tokens list API response
+	OrganizationApplicationUserTokensResponse struct {
+		APIResponse
+		Tokens []OrganizationApplicationUserToken `json:"tokens"`
+	}
+)
+
+// List returns a list of all organization application users
+func (h OrganizationApplicationUsersHandler) List(orgId string) (*OrganizationApplicationUsersResponse, error) {
+	if orgId == "" {
+		return nil, errors.New("cannot get a list of application users when organization id is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationApplicationUsersResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Create creates an organization application user
+func (h OrganizationApplicationUsersHandler) Create(orgId string, u OrganizationApplicationUser) (*OrganizationApplicationUserResponse, error) {
+	if orgId == "" {
+		return nil, errors.New("cannot create an application user when organization id is empty")
+	}
+
+	if u.Name == "" {
+		return nil, errors.New("cannot create an application user when name is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users")
+	bts, err := h.client.doPostRequest(path, u)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationApplicationUserResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Update partially updates an organization application user
+func (h OrganizationApplicationUsersHandler) Update(
+	orgId, userId string,
+	req UpdateOrganizationApplicationUserRequest,
+) (*OrganizationApplicationUserResponse, error) {
+	if orgId == "" || userId == "" {
+		return nil, errors.New("cannot update an application user when organization id or user id is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users", userId)
+	bts, err := h.client.doPatchRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationApplicationUserResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Delete deletes an organization application user
+func (h OrganizationApplicationUsersHandler) Delete(orgId, userId string) error {
+	if orgId == "" || userId == "" {
+		return errors.New("cannot delete an application user when organization id or user id is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users", userId)
+	bts, err := h.client.doDeleteRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
+
+// CreateToken creates an access token for an organization application user
+func (h OrganizationApplicationUsersHandler) CreateToken(
+	orgId, userId string,
+	req OrganizationApplicationUserTokenRequest,
+) (*OrganizationApplicationUserTokenResponse, error) {
+	if orgId == "" || userId == "" {
+		return nil, errors.New("cannot create an application user token when organization id or user id is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users", userId, "access-tokens")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationApplicationUserTokenResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// ListTokens returns a list of access tokens of an organization application user
+func (h OrganizationApplicationUsersHandler) ListTokens(orgId, userId string) (*OrganizationApplicationUserTokensResponse, error) {
+	if orgId == "" || userId == "" {
+		return nil, errors.New("cannot get a list of application user tokens when organization id or user id is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users", userId, "access-tokens")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationApplicationUserTokensResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// DeleteToken revokes an access token of an organization application user
+func (h OrganizationApplicationUsersHandler) DeleteToken(orgId, userId, tokenPrefix string) error {
+	if orgId == "" || userId == "" || tokenPrefix == "" {
+		return errors.New("cannot delete an application user token when organization id or user id or token prefix is empty")
+	}
+
+	path := buildPath("organization", orgId, "application-users", userId, "access-tokens", tokenPrefix)
+	bts, err := h.client.doDeleteRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
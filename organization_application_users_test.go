@@ -0,0 +1,56 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestOrganizationApplicationUsersHandler_Update(t *testing.T) {
+	var got map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/organization/org1234/application-users/u5678" || r.Method != "PATCH" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(OrganizationApplicationUserResponse{
+			OrganizationApplicationUser: OrganizationApplicationUser{UserId: "u5678", Name: "ci", IsSuperAdmin: true},
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	superAdmin := true
+	rsp, err := c.OrganizationApplicationUsers.Update("org1234", "u5678", UpdateOrganizationApplicationUserRequest{
+		IsSuperAdmin: &superAdmin,
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	// Only the given fields are sent, the name is left unchanged
+	if want := map[string]interface{}{"is_super_admin": true}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Update() got body = %v, want %v", got, want)
+	}
+
+	if rsp.Name != "ci" || !rsp.IsSuperAdmin {
+		t.Errorf("Update() got = %+v", rsp)
+	}
+
+	if _, err := c.OrganizationApplicationUsers.Update("", "u5678", UpdateOrganizationApplicationUserRequest{}); err == nil {
+		t.Error("Update() expected an error for an empty organization id")
+	}
+}
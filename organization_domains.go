@@ -0,0 +1,124 @@
+package aiven
+
+import (
+	"errors"
+	"time"
+)
+
+type (
+	// OrganizationDomainsHandler Aiven go-client handler for Organization Domains
+	OrganizationDomainsHandler struct {
+		client *Client
+	}
+
+	// OrganizationDomain represents an email domain of an organization. Once verified,
+	// users with email addresses of the domain can join the organization automatically.
+	OrganizationDomain struct {
+		DomainId         string     `json:"domain_id"`
+		DomainName       string     `json:"domain_name"`
+		OrganizationId   string     `json:"organization_id"`
+		State            string     `json:"state"`
+		VerificationType string     `json:"verification_type"`
+		ChallengeToken   string     `json:"challenge_token"`
+		CreateTime       *time.Time `json:"create_time,omitempty"`
+	}
+
+	// OrganizationDomainRequest represents a request to add a domain to an organization,
+	// VerificationType is either dns or http
+	OrganizationDomainRequest struct {
+		DomainName       string `json:"domain_name"`
+		VerificationType string `json:"verification_type"`
+	}
+
+	// OrganizationDomainResponse represents an organization domain API response
+	OrganizationDomainResponse struct {
+		APIResponse
+		OrganizationDomain
+	}
+
+	// OrganizationDomainsResponse represents organization domains list API response
+	OrganizationDomainsResponse struct {
+		APIResponse
+		Domains []OrganizationDomain `json:"domains"`
+	}
+)
+
+// List returns a list of all organization domains
+func (h OrganizationDomainsHandler) List(orgId string) (*OrganizationDomainsResponse, error) {
+	if orgId == "" {
+		return nil, errors.New("cannot get a list of domains when organization id is empty")
+	}
+
+	path := buildPath("organization", orgId, "domains")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationDomainsResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Create adds a domain to an organization, the response contains the challenge token
+// to publish for the verification
+func (h OrganizationDomainsHandler) Create(orgId string, req OrganizationDomainRequest) (*OrganizationDomainResponse, error) {
+	if orgId == "" {
+		return nil, errors.New("cannot create a domain when organization id is empty")
+	}
+
+	if req.DomainName == "" {
+		return nil, errors.New("cannot create a domain when domain name is empty")
+	}
+
+	path := buildPath("organization", orgId, "domains")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationDomainResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Verify triggers the verification of an organization domain
+func (h OrganizationDomainsHandler) Verify(orgId, domainId string) (*OrganizationDomainResponse, error) {
+	if orgId == "" || domainId == "" {
+		return nil, errors.New("cannot verify a domain when organization id or domain id is empty")
+	}
+
+	path := buildPath("organization", orgId, "domains", domainId, "verify")
+	bts, err := h.client.doPostRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationDomainResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Delete removes a domain from an organization
+func (h OrganizationDomainsHandler) Delete(orgId, domainId string) error {
+	if orgId == "" || domainId == "" {
+		return errors.New("cannot delete a domain when organization id or domain id is empty")
+	}
+
+	path := buildPath("organization", orgId, "domains", domainId)
+	bts, err := h.client.doDeleteRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
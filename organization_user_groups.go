@@ -0,0 +1,229 @@
+package aiven
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// UserGroupMembersAdd is the operation adding members to an organization user group
+	UserGroupMembersAdd = "add_members"
+	// UserGroupMembersRemove is the operation removing members from an organization user group
+	UserGroupMembersRemove = "remove_members"
+)
+
+type (
+	// OrganizationUserGroupsHandler Aiven go-client handler for Organization User Groups
+	OrganizationUserGroupsHandler struct {
+		client *Client
+	}
+
+	// OrganizationUserGroup represents a group of organization users, permissions can be
+	// granted to the group instead of each user separately
+	OrganizationUserGroup struct {
+		UserGroupId   string     `json:"user_group_id,omitempty"`
+		UserGroupName string     `json:"user_group_name"`
+		Description   string     `json:"description"`
+		MemberCount   int        `json:"member_count,omitempty"`
+		CreateTime    *time.Time `json:"create_time,omitempty"`
+		UpdateTime    *time.Time `json:"update_time,omitempty"`
+	}
+
+	// OrganizationUserGroupRequest represents a request to create an organization user group
+	OrganizationUserGroupRequest struct {
+		UserGroupName string `json:"user_group_name"`
+		Description   string `json:"description"`
+	}
+
+	// UpdateOrganizationUserGroupRequest represents a partial update of an organization user group,
+	// fields left nil are not changed
+	UpdateOrganizationUserGroupRequest struct {
+		UserGroupName *string `json:"user_group_name,omitempty"`
+		Description   *string `json:"description,omitempty"`
+	}
+
+	// OrganizationUserGroupResponse represents an organization user group API response
+	OrganizationUserGroupResponse struct {
+		APIResponse
+		OrganizationUserGroup
+	}
+
+	// OrganizationUserGroupsResponse represents organization user groups list API response
+	OrganizationUserGroupsResponse struct {
+		APIResponse
+		UserGroups []OrganizationUserGroup `json:"user_groups"`
+	}
+
+	// OrganizationUserGroupMember represents a member of an organization user group
+	OrganizationUserGroupMember struct {
+		UserId       string     `json:"user_id"`
+		LastActivity *time.Time `json:"last_activity_time,omitempty"`
+		UserInfo     struct {
+			RealName  string `json:"real_name"`
+			UserEmail string `json:"user_email"`
+		} `json:"user_info"`
+	}
+
+	// OrganizationUserGroupMembersResponse represents organization user group members list API response
+	OrganizationUserGroupMembersResponse struct {
+		APIResponse
+		Members []OrganizationUserGroupMember `json:"members"`
+	}
+
+	// OrganizationUserGroupMembersRequest represents a request to add or remove organization
+	// user group members, Operation is either UserGroupMembersAdd or UserGroupMembersRemove
+	OrganizationUserGroupMembersRequest struct {
+		Operation string   `json:"operation"`
+		MemberIds []string `json:"member_ids"`
+	}
+)
+
+// List returns a list of all organization user groups
+func (h OrganizationUserGroupsHandler) List(orgId string) (*OrganizationUserGroupsResponse, error) {
+	if orgId == "" {
+		return nil, errors.New("cannot get a list of user groups when organization id is empty")
+	}
+
+	path := buildPath("organization", orgId, "user-groups")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationUserGroupsResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Get returns an organization user group
+func (h OrganizationUserGroupsHandler) Get(orgId, userGroupId string) (*OrganizationUserGroupResponse, error) {
+	if orgId == "" || userGroupId == "" {
+		return nil, errors.New("cannot get a user group when organization id or user group id is empty")
+	}
+
+	path := buildPath("organization", orgId, "user-groups", userGroupId)
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationUserGroupResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Create creates an organization user group
+func (h OrganizationUserGroupsHandler) Create(orgId string, req OrganizationUserGroupRequest) (*OrganizationUserGroupResponse, error) {
+	if orgId == "" {
+		return nil, errors.New("cannot create a user group when organization id is empty")
+	}
+
+	if req.UserGroupName == "" {
+		return nil, errors.New("cannot create a user group when name is empty")
+	}
+
+	path := buildPath("organization", orgId, "user-groups")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationUserGroupResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Update partially updates an organization user group
+func (h OrganizationUserGroupsHandler) Update(
+	orgId, userGroupId string,
+	req UpdateOrganizationUserGroupRequest,
+) (*OrganizationUserGroupResponse, error) {
+	if orgId == "" || userGroupId == "" {
+		return nil, errors.New("cannot update a user group when organization id or user group id is empty")
+	}
+
+	path := buildPath("organization", orgId, "user-groups", userGroupId)
+	bts, err := h.client.doPatchRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationUserGroupResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// Delete deletes an organization user group
+func (h OrganizationUserGroupsHandler) Delete(orgId, userGroupId string) error {
+	if orgId == "" || userGroupId == "" {
+		return errors.New("cannot delete a user group when organization id or user group id is empty")
+	}
+
+	path := buildPath("organization", orgId, "user-groups", userGroupId)
+	bts, err := h.client.doDeleteRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
+
+// ListMembers returns a list of members of an organization user group
+func (h OrganizationUserGroupsHandler) ListMembers(orgId, userGroupId string) (*OrganizationUserGroupMembersResponse, error) {
+	if orgId == "" || userGroupId == "" {
+		return nil, errors.New("cannot get a list of user group members when organization id or user group id is empty")
+	}
+
+	path := buildPath("organization", orgId, "user-groups", userGroupId, "members")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var rsp OrganizationUserGroupMembersResponse
+	if errR := checkAPIResponse(bts, &rsp); errR != nil {
+		return nil, errR
+	}
+
+	return &rsp, nil
+}
+
+// AddMembers adds users to an organization user group
+func (h OrganizationUserGroupsHandler) AddMembers(orgId, userGroupId string, userIds ...string) error {
+	return h.modifyMembers(orgId, userGroupId, UserGroupMembersAdd, userIds)
+}
+
+// RemoveMembers removes users from an organization user group
+func (h OrganizationUserGroupsHandler) RemoveMembers(orgId, userGroupId string, userIds ...string) error {
+	return h.modifyMembers(orgId, userGroupId, UserGroupMembersRemove, userIds)
+}
+
+func (h OrganizationUserGroupsHandler) modifyMembers(orgId, userGroupId, operation string, userIds []string) error {
+	if orgId == "" || userGroupId == "" {
+		return errors.New("cannot modify user group members when organization id or user group id is empty")
+	}
+
+	if len(userIds) == 0 {
+		return errors.New("cannot modify user group members when no user ids are given")
+	}
+
+	path := buildPath("organization", orgId, "user-groups", userGroupId, "members")
+	bts, err := h.client.doPatchRequest(path, OrganizationUserGroupMembersRequest{Operation: operation, MemberIds: userIds})
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
@@ -0,0 +1,289 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func setupOrganizationUserGroupsTestCase(t *testing.T) (*Client, func(t *testing.T)) {
+	t.Log("setup Organization User Groups test case")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.URL.Path == "/organization/org2c5a4d5c/user-groups" {
+			// get a list of user groups
+			if r.Method == "GET" {
+				err := json.NewEncoder(w).Encode(OrganizationUserGroupsResponse{
+					UserGroups: []OrganizationUserGroup{
+						{
+							UserGroupId:   "ug2c5a4d5e",
+							UserGroupName: "developers",
+							Description:   "Developers",
+							MemberCount:   2,
+							CreateTime:    getTime(t),
+						},
+					},
+				})
+
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			// create a new user group
+			if r.Method == "POST" {
+				var req OrganizationUserGroupRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Error(err)
+				}
+
+				err := json.NewEncoder(w).Encode(OrganizationUserGroupResponse{
+					OrganizationUserGroup: OrganizationUserGroup{
+						UserGroupId:   "ug2c5a4d5f",
+						UserGroupName: req.UserGroupName,
+						Description:   req.Description,
+						CreateTime:    getTime(t),
+					},
+				})
+
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+		}
+
+		if r.URL.Path == "/organization/org2c5a4d5c/user-groups/ug2c5a4d5e" {
+			switch r.Method {
+			case "GET":
+				err := json.NewEncoder(w).Encode(OrganizationUserGroupResponse{
+					OrganizationUserGroup: OrganizationUserGroup{
+						UserGroupId:   "ug2c5a4d5e",
+						UserGroupName: "developers",
+						Description:   "Developers",
+						MemberCount:   2,
+						CreateTime:    getTime(t),
+					},
+				})
+
+				if err != nil {
+					t.Error(err)
+				}
+			case "PATCH":
+				// only the given fields are sent
+				var req map[string]interface{}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Error(err)
+				}
+				if _, ok := req["user_group_name"]; ok {
+					t.Errorf("unexpected user_group_name in partial update %v", req)
+				}
+
+				err := json.NewEncoder(w).Encode(OrganizationUserGroupResponse{
+					OrganizationUserGroup: OrganizationUserGroup{
+						UserGroupId:   "ug2c5a4d5e",
+						UserGroupName: "developers",
+						Description:   req["description"].(string),
+						MemberCount:   2,
+						CreateTime:    getTime(t),
+					},
+				})
+
+				if err != nil {
+					t.Error(err)
+				}
+			case "DELETE":
+				_, _ = w.Write([]byte(`{}`))
+			default:
+				t.Errorf("unexpected method %s", r.Method)
+			}
+			return
+		}
+
+		if r.URL.Path == "/organization/org2c5a4d5c/user-groups/ug2c5a4d5e/members" {
+			// get a list of user group members
+			if r.Method == "GET" {
+				err := json.NewEncoder(w).Encode(OrganizationUserGroupMembersResponse{
+					Members: []OrganizationUserGroupMember{{UserId: "u2c5a4d60"}},
+				})
+
+				if err != nil {
+					t.Error(err)
+				}
+				return
+			}
+
+			// add or remove members
+			if r.Method == "PATCH" {
+				var req OrganizationUserGroupMembersRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Error(err)
+				}
+
+				want := []string{"u2c5a4d60", "u2c5a4d61"}
+				if (req.Operation != UserGroupMembersAdd && req.Operation != UserGroupMembersRemove) ||
+					!reflect.DeepEqual(req.MemberIds, want) {
+					t.Errorf("unexpected members request %v", req)
+				}
+
+				_, _ = w.Write([]byte(`{}`))
+				return
+			}
+		}
+
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	return c, func(t *testing.T) {
+		t.Log("teardown Organization User Groups test case")
+		ts.Close()
+	}
+}
+
+func TestOrganizationUserGroupsHandler_List(t *testing.T) {
+	c, tearDown := setupOrganizationUserGroupsTestCase(t)
+	defer tearDown(t)
+
+	got, err := c.OrganizationUserGroups.List("org2c5a4d5c")
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(got.UserGroups) != 1 || got.UserGroups[0].UserGroupId != "ug2c5a4d5e" || got.UserGroups[0].MemberCount != 2 {
+		t.Errorf("List() got = %v", got.UserGroups)
+	}
+
+	if _, err := c.OrganizationUserGroups.List(""); err == nil {
+		t.Error("List() with an empty organization id, expected an error")
+	}
+}
+
+func TestOrganizationUserGroupsHandler_Get(t *testing.T) {
+	c, tearDown := setupOrganizationUserGroupsTestCase(t)
+	defer tearDown(t)
+
+	tests := []struct {
+		name        string
+		orgId       string
+		userGroupId string
+		want        string
+		wantErr     bool
+	}{
+		{"normal", "org2c5a4d5c", "ug2c5a4d5e", "developers", false},
+		{"error-empty-org-id", "", "ug2c5a4d5e", "", true},
+		{"error-empty-user-group-id", "org2c5a4d5c", "", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.OrganizationUserGroups.Get(tt.orgId, tt.userGroupId)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.UserGroupName != tt.want {
+				t.Errorf("Get() got = %v, want %v", got.UserGroupName, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrganizationUserGroupsHandler_Create(t *testing.T) {
+	c, tearDown := setupOrganizationUserGroupsTestCase(t)
+	defer tearDown(t)
+
+	tests := []struct {
+		name    string
+		orgId   string
+		req     OrganizationUserGroupRequest
+		wantErr bool
+	}{
+		{"normal", "org2c5a4d5c", OrganizationUserGroupRequest{UserGroupName: "ops", Description: "Operators"}, false},
+		{"error-empty-org-id", "", OrganizationUserGroupRequest{UserGroupName: "ops"}, true},
+		{"error-empty-name", "org2c5a4d5c", OrganizationUserGroupRequest{Description: "Operators"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.OrganizationUserGroups.Create(tt.orgId, tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (got.UserGroupId != "ug2c5a4d5f" || got.UserGroupName != tt.req.UserGroupName) {
+				t.Errorf("Create() got = %v", got.OrganizationUserGroup)
+			}
+		})
+	}
+}
+
+func TestOrganizationUserGroupsHandler_Update(t *testing.T) {
+	c, tearDown := setupOrganizationUserGroupsTestCase(t)
+	defer tearDown(t)
+
+	description := "Backend developers"
+	got, err := c.OrganizationUserGroups.Update("org2c5a4d5c", "ug2c5a4d5e", UpdateOrganizationUserGroupRequest{
+		Description: &description,
+	})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if got.Description != description || got.UserGroupName != "developers" {
+		t.Errorf("Update() got = %v", got.OrganizationUserGroup)
+	}
+
+	if _, err := c.OrganizationUserGroups.Update("org2c5a4d5c", "", UpdateOrganizationUserGroupRequest{}); err == nil {
+		t.Error("Update() with an empty user group id, expected an error")
+	}
+}
+
+func TestOrganizationUserGroupsHandler_Delete(t *testing.T) {
+	c, tearDown := setupOrganizationUserGroupsTestCase(t)
+	defer tearDown(t)
+
+	if err := c.OrganizationUserGroups.Delete("org2c5a4d5c", "ug2c5a4d5e"); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+
+	if err := c.OrganizationUserGroups.Delete("", "ug2c5a4d5e"); err == nil {
+		t.Error("Delete() with an empty organization id, expected an error")
+	}
+}
+
+func TestOrganizationUserGroupsHandler_Members(t *testing.T) {
+	c, tearDown := setupOrganizationUserGroupsTestCase(t)
+	defer tearDown(t)
+
+	got, err := c.OrganizationUserGroups.ListMembers("org2c5a4d5c", "ug2c5a4d5e")
+	if err != nil {
+		t.Fatalf("ListMembers() error = %v", err)
+	}
+	if len(got.Members) != 1 || got.Members[0].UserId != "u2c5a4d60" {
+		t.Errorf("ListMembers() got = %v", got.Members)
+	}
+
+	if err := c.OrganizationUserGroups.AddMembers("org2c5a4d5c", "ug2c5a4d5e", "u2c5a4d60", "u2c5a4d61"); err != nil {
+		t.Errorf("AddMembers() error = %v", err)
+	}
+
+	if err := c.OrganizationUserGroups.RemoveMembers("org2c5a4d5c", "ug2c5a4d5e", "u2c5a4d60", "u2c5a4d61"); err != nil {
+		t.Errorf("RemoveMembers() error = %v", err)
+	}
+
+	if err := c.OrganizationUserGroups.AddMembers("org2c5a4d5c", "ug2c5a4d5e"); err == nil {
+		t.Error("AddMembers() without user ids, expected an error")
+	}
+
+	if err := c.OrganizationUserGroups.RemoveMembers("org2c5a4d5c", ""); err == nil {
+		t.Error("RemoveMembers() with an empty user group id, expected an error")
+	}
+
+	if _, err := c.OrganizationUserGroups.ListMembers("", "ug2c5a4d5e"); err == nil {
+		t.Error("ListMembers() with an empty organization id, expected an error")
+	}
+}
@@ -89,6 +89,21 @@ type (
 		Events []*ProjectEvent `json:"events"`
 	}
 
+	// Cloud represents a cloud available to a project
+	Cloud struct {
+		Name         string  `json:"cloud_name"`
+		Description  string  `json:"cloud_description"`
+		GeoLatitude  float64 `json:"geo_latitude"`
+		GeoLongitude float64 `json:"geo_longitude"`
+		GeoRegion    string  `json:"geo_region"`
+	}
+
+	// ProjectCloudsResponse is the response from Aiven for listing project clouds
+	ProjectCloudsResponse struct {
+		APIResponse
+		Clouds []*Cloud `json:"clouds"`
+	}
+
 	// ProjectEvent represents a project event log entry
 	ProjectEvent struct {
 		Actor       string `json:"actor"`
@@ -180,7 +195,8 @@ func (h *ProjectsHandler) Delete(project string) error {
 	return checkAPIResponse(bts, nil)
 }
 
-// List returns all the available projects linked to the account.
+// List returns all the available projects linked to the account. The API is not paginated,
+// every project is returned in a single response.
 func (h *ProjectsHandler) List() ([]*Project, error) {
 	bts, err := h.client.doGetRequest(buildPath("project"), nil)
 	if err != nil {
@@ -205,3 +221,78 @@ func (h *ProjectsHandler) GetEventLog(project string) ([]*ProjectEvent, error) {
 
 	return r.Events, errR
 }
+
+// ListClouds returns the clouds available to the project
+func (h *ProjectsHandler) ListClouds(project string) ([]*Cloud, error) {
+	bts, err := h.client.doGetRequest(buildPath("project", project, "clouds"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ProjectCloudsResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.Clouds, errR
+}
+
+// EstimatedCost returns the current run-rate of the project as the sum of its services run-rates
+func (h *ProjectsHandler) EstimatedCost(project string) (*CostEstimate, error) {
+	services, err := h.client.Services.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &CostEstimate{Currency: "USD"}
+	for _, s := range services {
+		e, err := h.client.Services.estimatedCost(project, s)
+		if err != nil {
+			return nil, err
+		}
+
+		total.HourlyPrice += e.HourlyPrice
+		total.MonthlyPrice += e.MonthlyPrice
+	}
+
+	return total, nil
+}
+
+// MyRole returns the member type (admin, developer, operator or read_only) the authenticated
+// user has in the project, either directly or through a team
+func (h *ProjectsHandler) MyRole(project string) (string, error) {
+	me, err := h.client.Me()
+	if err != nil {
+		return "", err
+	}
+
+	role, ok := me.ProjectMembership[project]
+	if !ok {
+		return "", Error{Message: "User is not a member of project " + project, Status: 404}
+	}
+
+	return role, nil
+}
+
+// GetBillingGroup returns the billing group the project is assigned to
+func (h *ProjectsHandler) GetBillingGroup(project string) (*BillingGroup, error) {
+	p, err := h.Get(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.BillingGroupId == "" {
+		return nil, Error{Message: "Project " + project + " has no billing group", Status: 404}
+	}
+
+	return h.client.BillingGroup.Get(p.BillingGroupId)
+}
+
+// SetBillingGroup assigns the project to the billing group, leaving the other project fields untouched
+func (h *ProjectsHandler) SetBillingGroup(project, billingGroupID string) error {
+	return h.client.BillingGroup.AssignProjects(billingGroupID, []string{project})
+}
+
+// ListServiceTypes returns the service types available to the project keyed by their name,
+// e.g. pg or kafka, see ServiceTypesHandler.List
+func (h *ProjectsHandler) ListServiceTypes(project string) (map[string]*ServiceType, error) {
+	return h.client.ServiceTypes.List(project)
+}
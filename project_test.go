@@ -77,6 +77,22 @@ func setupProjectTestCase(t *testing.T) (*Client, func(t *testing.T)) {
 			return
 		}
 
+		if r.URL.Path == "/me" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(MeResponse{
+				User: Me{
+					Email:             UserName,
+					ProjectMembership: map[string]string{"test-pr": "developer"},
+				},
+			})
+
+			if err != nil {
+				t.Error(err)
+			}
+			return
+		}
+
 		if r.URL.Path == "/project" {
 			if r.Method == "POST" {
 				w.Header().Set("Content-Type", "application/json")
@@ -366,3 +382,30 @@ func TestProjectsHandler_List(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectsHandler_MyRole(t *testing.T) {
+	c, tearDown := setupProjectTestCase(t)
+	defer tearDown(t)
+
+	tests := []struct {
+		name    string
+		project string
+		want    string
+		wantErr bool
+	}{
+		{"member", "test-pr", "developer", false},
+		{"not-member", "test-pr-other", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Projects.MyRole(tt.project)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MyRole() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("MyRole() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,51 @@
 
 package aiven
 
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ComponentRouteDynamic is the component route used for access from within a project VPC
+	ComponentRouteDynamic = "dynamic"
+	// ComponentRoutePublic is the component route used for access over the public internet
+	ComponentRoutePublic = "public"
+	// ComponentRoutePrivatelink is the component route used for access over a privatelink
+	ComponentRoutePrivatelink = "privatelink"
+
+	// KafkaAuthenticationMethodCertificate is the Kafka authentication method using client certificates
+	KafkaAuthenticationMethodCertificate = "certificate"
+	// KafkaAuthenticationMethodSASL is the Kafka authentication method using SASL
+	KafkaAuthenticationMethodSASL = "sasl"
+
+	// ComponentKafka is the component of the Kafka brokers of a service
+	ComponentKafka = "kafka"
+	// ComponentKafkaConnect is the component of the Kafka Connect REST API of a service
+	ComponentKafkaConnect = "kafka_connect"
+
+	// ServiceStateRunning is the state of a service which is up and running
+	ServiceStateRunning = "RUNNING"
+	// ServiceStatePoweroff is the state of a service which is powered off
+	ServiceStatePoweroff = "POWEROFF"
+	// ServiceStateRebuilding is the state of a service whose nodes are being replaced, e.g.
+	// during maintenance, a plan change or a migration
+	ServiceStateRebuilding = "REBUILDING"
+)
+
+// hoursPerMonth is the average number of hours in a month used for monthly cost estimates
+const hoursPerMonth = 730
+
+// servicePollInterval is how often service state is polled when waiting for it to change
+var servicePollInterval = 10 * time.Second
+
 type (
 	// Service represents the Service model on Aiven.
 	Service struct {
@@ -33,8 +78,22 @@ type (
 		NodeStates            []*NodeState           `json:"node_states"`
 		DiskSpaceMB           int                    `json:"disk_space_mb"`
 		Features              ServiceFeatures        `json:"features"`
+		Tags                  map[string]string      `json:"tags"`
+		TechnicalEmails       []*ContactEmail        `json:"tech_emails"`
 	}
 
+	// ServiceURIParams are the connection parameters of a service, as given by service_uri_params.
+	// Unlike the service URI they don't need unescaping, e.g. for passwords with special characters.
+	ServiceURIParams struct {
+		Host         string
+		Port         int
+		User         string
+		Password     string
+		DatabaseName string
+		SSLMode      string
+	}
+
+	// ServiceFeatures are the capabilities available to a service
 	ServiceFeatures struct {
 		EnhancedLogging                bool `json:"enhanced_logging"`
 		ImprovedTopicManagement        bool `json:"improved_topic_management"`
@@ -92,6 +151,12 @@ type (
 		DataSize   int    `json:"data_size"`
 	}
 
+	// RecoveryWindow is the time range a service can be restored to with point-in-time recovery
+	RecoveryWindow struct {
+		Earliest time.Time
+		Latest   time.Time
+	}
+
 	// ConnectionInfo represents the Service Connection information on Aiven.
 	ConnectionInfo struct {
 		CassandraHosts []string `json:"cassandra"`
@@ -162,8 +227,17 @@ type (
 
 	// MaintenanceWindow during which maintenance operations should take place
 	MaintenanceWindow struct {
-		DayOfWeek string `json:"dow"`
-		TimeOfDay string `json:"time"`
+		DayOfWeek string               `json:"dow"`
+		TimeOfDay string               `json:"time"`
+		Updates   []*MaintenanceUpdate `json:"updates,omitempty"`
+	}
+
+	// MaintenanceUpdate is a pending update applied during the maintenance window
+	MaintenanceUpdate struct {
+		Description string `json:"description"`
+		Deadline    string `json:"deadline,omitempty"`
+		StartAfter  string `json:"start_after,omitempty"`
+		StartAt     string `json:"start_at,omitempty"`
 	}
 
 	// ServicesHandler is the client that interacts with the Service API
@@ -185,6 +259,8 @@ type (
 		UserConfig            map[string]interface{}  `json:"user_config,omitempty"`
 		ServiceIntegrations   []NewServiceIntegration `json:"service_integrations"`
 		DiskSpaceMB           int                     `json:"disk_space_mb,omitempty"`
+		Tags                  map[string]string       `json:"tags,omitempty"`
+		TechnicalEmails       *[]*ContactEmail        `json:"tech_emails,omitempty"`
 	}
 
 	// UpdateServiceRequest are the parameters to update a Service.
@@ -199,6 +275,40 @@ type (
 		UserConfig            map[string]interface{} `json:"user_config,omitempty"`
 		DiskSpaceMB           int                    `json:"disk_space_mb,omitempty"`
 		Karapace              *bool                  `json:"karapace,omitempty"`
+		Tags                  map[string]string      `json:"tags,omitempty"`
+		TechnicalEmails       *[]*ContactEmail       `json:"tech_emails,omitempty"`
+	}
+
+	// WaitOption configures how a service is polled while waiting for it
+	WaitOption func(*waitOptions)
+
+	waitOptions struct {
+		pollInterval time.Duration
+		backoff      float64
+		maxInterval  time.Duration
+	}
+
+	// ServiceTagResult represents the outcome of tagging a single service
+	ServiceTagResult struct {
+		Service string
+		Err     error
+	}
+
+	// ServiceHealth represents the state and node readiness of a single service, Err is
+	// set when the service was not found
+	ServiceHealth struct {
+		Service      string
+		State        string
+		NodesRunning int
+		NodesTotal   int
+		Err          error
+	}
+
+	// CostEstimate represents the current run-rate of a service or a project
+	CostEstimate struct {
+		HourlyPrice  float64
+		MonthlyPrice float64
+		Currency     string
 	}
 
 	// ServiceResponse represents the response from Aiven after interacting with
@@ -228,8 +338,200 @@ func (s *Service) Port() (string, error) {
 	return s.URIParams["port"], nil
 }
 
+// ParsedURIParams returns the typed connection parameters of the service. Parameters not
+// used by the service type, e.g. the database name of a Kafka service, are empty.
+func (s *Service) ParsedURIParams() (*ServiceURIParams, error) {
+	p := &ServiceURIParams{
+		Host:         s.URIParams["host"],
+		User:         s.URIParams["user"],
+		Password:     s.URIParams["password"],
+		DatabaseName: s.URIParams["dbname"],
+		SSLMode:      s.URIParams["sslmode"],
+	}
+
+	if port := s.URIParams["port"]; port != "" {
+		var err error
+		if p.Port, err = strconv.Atoi(port); err != nil {
+			return nil, fmt.Errorf("cannot parse service port %q: %w", port, err)
+		}
+	}
+
+	return p, nil
+}
+
+// FeatureEnabled returns true if the feature, given by its API name such as `kafka_connect`
+// or `schema_registry`, is available to the service. Features unknown to the client are
+// reported as not available.
+func (s *Service) FeatureEnabled(name string) bool {
+	v := reflect.ValueOf(s.Features)
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("json") == name {
+			return v.Field(i).Bool()
+		}
+	}
+
+	return false
+}
+
+// HasTags returns true if the service has all of the given tags with the same values.
+func (s *Service) HasTags(tags map[string]string) bool {
+	for k, v := range tags {
+		if t, ok := s.Tags[k]; !ok || t != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// KafkaBootstrapServers returns the comma separated `host:port` list of the Kafka
+// components available over the given route, see the ComponentRoute constants.
+func (s *Service) KafkaBootstrapServers(route string) string {
+	var servers []string
+	for _, c := range s.Components {
+		if c.Component == ComponentKafka && c.Route == route {
+			servers = append(servers, c.Host+":"+strconv.Itoa(c.Port))
+		}
+	}
+
+	return strings.Join(servers, ",")
+}
+
+// KafkaComponent returns the Kafka component supporting the given authentication method
+// over the given route, or nil if the service has none.
+func (s *Service) KafkaComponent(authenticationMethod, route string) *ServiceComponents {
+	for _, c := range s.Components {
+		if c.Component == ComponentKafka && c.Route == route && c.KafkaAuthenticationMethod == authenticationMethod {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// Component returns the component of the service with the given name and route, e.g.
+// ComponentKafkaConnect and ComponentRoutePublic, or nil when there is none.
+func (s *Service) Component(name, route string) *ServiceComponents {
+	for _, c := range s.Components {
+		if c.Component == name && c.Route == route {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// KafkaConnectURI returns the URI of the Kafka Connect REST API of the service, including
+// the credentials of the primary user, for the given route. It works for both standalone
+// Kafka Connect services and Kafka services running Kafka Connect.
+func (s *Service) KafkaConnectURI(route string) (string, error) {
+	c := s.Component(ComponentKafkaConnect, route)
+	if c == nil {
+		return "", fmt.Errorf("service %s has no %s Kafka Connect component", s.Name, route)
+	}
+
+	u := url.URL{Scheme: "http", Host: net.JoinHostPort(c.Host, strconv.Itoa(c.Port))}
+	if c.Ssl == nil || *c.Ssl {
+		u.Scheme = "https"
+	}
+
+	username, password := s.URIParams["user"], s.URIParams["password"]
+	if username == "" {
+		for _, user := range s.Users {
+			if user.Type == "primary" {
+				username, password = user.Username, user.Password
+				break
+			}
+		}
+	}
+	if username != "" {
+		u.User = url.UserPassword(username, password)
+	}
+
+	return u.String(), nil
+}
+
+// Progress returns the completion of the node's progress updates as a percentage.
+// A node without progress updates is complete once it is running.
+func (n *NodeState) Progress() float64 {
+	if len(n.ProgressUpdates) == 0 {
+		if n.State == "running" {
+			return 100
+		}
+		return 0
+	}
+
+	var total float64
+	for _, u := range n.ProgressUpdates {
+		switch {
+		case u.Completed:
+			total++
+		case u.Max > u.Min:
+			total += float64(u.Current-u.Min) / float64(u.Max-u.Min)
+		}
+	}
+
+	return total / float64(len(n.ProgressUpdates)) * 100
+}
+
+// Progress returns the average progress of all service nodes as a percentage,
+// e.g. during a rebuild, migration or a version upgrade.
+func (s *Service) Progress() float64 {
+	if len(s.NodeStates) == 0 {
+		return 0
+	}
+
+	var total float64
+	for _, n := range s.NodeStates {
+		total += n.Progress()
+	}
+
+	return total / float64(len(s.NodeStates))
+}
+
+// Validate checks the integrations created along with the service: each must have a type and
+// at most one service or endpoint on either side. The service being created is the side which
+// is left unset, or it is referred to by its name.
+func (r CreateServiceRequest) Validate() error {
+	for i, integration := range r.ServiceIntegrations {
+		if integration.IntegrationType == "" {
+			return fmt.Errorf("service integration %d: integration_type is required", i)
+		}
+
+		source := isSetString(integration.SourceService) || isSetString(integration.SourceEndpointID)
+		if isSetString(integration.SourceService) && isSetString(integration.SourceEndpointID) {
+			return fmt.Errorf("service integration %d: source_service and source_endpoint_id are mutually exclusive", i)
+		}
+
+		dest := isSetString(integration.DestinationService) || isSetString(integration.DestinationEndpointID)
+		if isSetString(integration.DestinationService) && isSetString(integration.DestinationEndpointID) {
+			return fmt.Errorf("service integration %d: dest_service and dest_endpoint_id are mutually exclusive", i)
+		}
+
+		if !source && !dest {
+			return fmt.Errorf("service integration %d: source or destination is required", i)
+		}
+
+		if source && dest &&
+			!(isSetString(integration.SourceService) && *integration.SourceService == r.ServiceName) &&
+			!(isSetString(integration.DestinationService) && *integration.DestinationService == r.ServiceName) {
+			return fmt.Errorf("service integration %d: either side must be service %s", i, r.ServiceName)
+		}
+	}
+
+	return nil
+}
+
+func isSetString(s *string) bool {
+	return s != nil && *s != ""
+}
+
 // Create creates the given Service on Aiven.
 func (h *ServicesHandler) Create(project string, req CreateServiceRequest) (*Service, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
 	path := buildPath("project", project, "service")
 	bts, err := h.client.doPostRequest(path, req)
 	if err != nil {
@@ -281,7 +583,8 @@ func (h *ServicesHandler) Delete(project, service string) error {
 	return checkAPIResponse(bts, nil)
 }
 
-// List will fetch all services for a given project.
+// List will fetch all services for a given project. The API is not paginated, every service
+// of the project is returned in a single response.
 func (h *ServicesHandler) List(project string) ([]*Service, error) {
 	path := buildPath("project", project, "service")
 	bts, err := h.client.doGetRequest(path, nil)
@@ -294,3 +597,696 @@ func (h *ServicesHandler) List(project string) ([]*Service, error) {
 
 	return r.Services, errR
 }
+
+// ListByTag lists the services of a project having all of the given tags. Aiven has no
+// server side tag filtering, so the services are filtered after listing them.
+func (h *ServicesHandler) ListByTag(project string, tags map[string]string) ([]*Service, error) {
+	services, err := h.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []*Service
+	for _, s := range services {
+		if s.HasTags(tags) {
+			filtered = append(filtered, s)
+		}
+	}
+
+	return filtered, nil
+}
+
+// HealthSnapshot returns the state and node readiness of the services in the given order.
+// Listing the services returns the state of all of them in a single request, so it is
+// used instead of getting each service.
+func (h *ServicesHandler) HealthSnapshot(project string, services []string) ([]ServiceHealth, error) {
+	list, err := h.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*Service, len(list))
+	for _, s := range list {
+		byName[s.Name] = s
+	}
+
+	results := make([]ServiceHealth, len(services))
+	for i, name := range services {
+		results[i].Service = name
+
+		s, ok := byName[name]
+		if !ok {
+			results[i].Err = Error{Message: "Service " + name + " not found", Status: 404}
+			continue
+		}
+
+		results[i].State = s.State
+		results[i].NodesTotal = len(s.NodeStates)
+		for _, n := range s.NodeStates {
+			if n.State == "running" {
+				results[i].NodesRunning++
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// Ready returns true if the service is running and so are all of its nodes
+func (sh ServiceHealth) Ready() bool {
+	return sh.Err == nil && sh.State == ServiceStateRunning && sh.NodesRunning == sh.NodesTotal
+}
+
+// TagMany sets the tags of the services concurrently and returns a result per service in the
+// given order. When merge is true the tags are added to the existing tags of each service,
+// otherwise they replace them.
+func (h *ServicesHandler) TagMany(project string, services []string, tags map[string]string, merge bool) []ServiceTagResult {
+	results := make([]ServiceTagResult, len(services))
+	forEachParallel(len(services), func(i int) {
+		results[i] = ServiceTagResult{
+			Service: services[i],
+			Err:     h.tag(project, services[i], tags, merge),
+		}
+	})
+
+	return results
+}
+
+func (h *ServicesHandler) tag(project, service string, tags map[string]string, merge bool) error {
+	if len(tags) == 0 {
+		return errors.New("cannot tag a service when tags are empty")
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return err
+	}
+
+	if merge && s.HasTags(tags) {
+		return nil
+	}
+
+	req := updateRequestFromService(s)
+	req.Tags = make(map[string]string, len(s.Tags)+len(tags))
+	if merge {
+		for k, v := range s.Tags {
+			req.Tags[k] = v
+		}
+	}
+	for k, v := range tags {
+		req.Tags[k] = v
+	}
+
+	_, err = h.Update(project, service, req)
+	return err
+}
+
+// KafkaBootstrapServers returns the comma separated Kafka bootstrap servers of a
+// service for the given access route, see the ComponentRoute constants.
+func (h *ServicesHandler) KafkaBootstrapServers(project, service, route string) (string, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return "", err
+	}
+
+	servers := s.KafkaBootstrapServers(route)
+	if servers == "" {
+		return "", Error{Message: fmt.Sprintf("No Kafka components with route %v found", route), Status: 404}
+	}
+
+	return servers, nil
+}
+
+// StateHistory returns the events of the project log concerning the service, newest first.
+// Aiven has no dedicated state history, the events (e.g. service_create, service_update,
+// service_poweroff, service_poweron) make up the timeline of the service.
+func (h *ServicesHandler) StateHistory(project, service string) ([]*ProjectEvent, error) {
+	events, err := h.client.Projects.GetEventLog(project)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []*ProjectEvent
+	for _, e := range events {
+		if e.ServiceName == service {
+			history = append(history, e)
+		}
+	}
+
+	return history, nil
+}
+
+// RecoveryWindow returns the time range the PostgreSQL or MySQL service can be restored to,
+// e.g. as the recovery_target_time of a fork. It begins with the oldest base backup and,
+// as the write-ahead log is archived continuously, ends at the current time.
+func (h *ServicesHandler) RecoveryWindow(project, service string) (*RecoveryWindow, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Type != "pg" && s.Type != "mysql" {
+		return nil, fmt.Errorf("service %s of type %s does not support point-in-time recovery", service, s.Type)
+	}
+
+	var earliest time.Time
+	for _, b := range s.Backups {
+		t, err := time.Parse(time.RFC3339, b.BackupTime)
+		if err != nil {
+			return nil, fmt.Errorf("cannot parse backup time %q: %w", b.BackupTime, err)
+		}
+
+		if earliest.IsZero() || t.Before(earliest) {
+			earliest = t
+		}
+	}
+
+	if earliest.IsZero() {
+		return nil, Error{Message: "Service " + service + " has no backups to recover from", Status: 404}
+	}
+
+	return &RecoveryWindow{Earliest: earliest, Latest: time.Now().UTC()}, nil
+}
+
+// GetBackupSchedule returns the hour and minute (UTC) the daily backup of the service starts
+// at. They are nil when not set in the user config, in which case Aiven picks the time.
+func (h *ServicesHandler) GetBackupSchedule(project, service string) (hour, minute *int, err error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if hour, err = userConfigInt(s.UserConfig, "backup_hour"); err != nil {
+		return nil, nil, err
+	}
+
+	if minute, err = userConfigInt(s.UserConfig, "backup_minute"); err != nil {
+		return nil, nil, err
+	}
+
+	return hour, minute, nil
+}
+
+// SetBackupSchedule sets the hour (0-23) and minute (0-59) in UTC the daily backup of the service
+// starts at. The rest of the user config is left untouched.
+func (h *ServicesHandler) SetBackupSchedule(project, service string, hour, minute int) (*Service, error) {
+	if hour < 0 || hour > 23 {
+		return nil, fmt.Errorf("backup hour must be between 0 and 23, got %d", hour)
+	}
+
+	if minute < 0 || minute > 59 {
+		return nil, fmt.Errorf("backup minute must be between 0 and 59, got %d", minute)
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.updateUserConfig(project, s, map[string]interface{}{
+		"backup_hour":   hour,
+		"backup_minute": minute,
+	})
+}
+
+// SetOpenSearchDashboards enables or disables OpenSearch Dashboards of an OpenSearch service.
+// maxOldSpaceSize is the memory in MB available to the Dashboards Node.js process, zero keeps
+// the current value. The rest of the user config is left untouched.
+func (h *ServicesHandler) SetOpenSearchDashboards(project, service string, enabled bool, maxOldSpaceSize int) (*Service, error) {
+	if maxOldSpaceSize != 0 && (maxOldSpaceSize < 64 || maxOldSpaceSize > 2048) {
+		return nil, fmt.Errorf("opensearch dashboards max old space size must be between 64 and 2048, got %d", maxOldSpaceSize)
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboards := map[string]interface{}{"enabled": enabled}
+	if maxOldSpaceSize != 0 {
+		dashboards["max_old_space_size"] = maxOldSpaceSize
+	}
+
+	return h.updateUserConfig(project, s, map[string]interface{}{
+		"opensearch_dashboards": mergeUserConfigObject(s.UserConfig, "opensearch_dashboards", dashboards),
+	})
+}
+
+// SetAdditionalBackupRegions sets the clouds the backups of the service are copied to. The
+// clouds must be available to the project. The rest of the user config is left untouched.
+func (h *ServicesHandler) SetAdditionalBackupRegions(project, service string, clouds []string) (*Service, error) {
+	available, err := h.client.Projects.ListClouds(project)
+	if err != nil {
+		return nil, err
+	}
+
+	regions := make([]interface{}, 0, len(clouds))
+	for _, name := range clouds {
+		var ok bool
+		for _, c := range available {
+			if c.Name == name {
+				ok = true
+				break
+			}
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("cloud %s is not available to project %s", name, project)
+		}
+		regions = append(regions, name)
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.updateUserConfig(project, s, map[string]interface{}{"additional_backup_regions": regions})
+}
+
+// GetTechnicalEmails returns the technical contacts of the service, which receive its
+// notifications instead of the technical contacts of the project when set.
+func (h *ServicesHandler) GetTechnicalEmails(project, service string) ([]string, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	emails := make([]string, 0, len(s.TechnicalEmails))
+	for _, e := range s.TechnicalEmails {
+		emails = append(emails, e.Email)
+	}
+
+	return emails, nil
+}
+
+// SetTechnicalEmails sets the technical contacts of the service. An empty list removes them,
+// in which case the technical contacts of the project receive the service notifications.
+func (h *ServicesHandler) SetTechnicalEmails(project, service string, emails []string) (*Service, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	contacts := make([]*ContactEmail, 0, len(emails))
+	for _, e := range emails {
+		contacts = append(contacts, &ContactEmail{Email: e})
+	}
+
+	req := updateRequestFromService(s)
+	req.TechnicalEmails = &contacts
+
+	return h.Update(project, service, req)
+}
+
+// userConfigInt returns the integer value of the user config key, or nil when it is not set
+func userConfigInt(userConfig map[string]interface{}, key string) (*int, error) {
+	v, ok := userConfig[key]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	i, err := ToInt64(v)
+	if err != nil {
+		return nil, fmt.Errorf("user config %s: %w", key, err)
+	}
+
+	r := int(i)
+	return &r, nil
+}
+
+// updateRequestFromService builds an update request keeping the current state of the service,
+// fields which aren't omitted when empty would otherwise be reset by an update
+func updateRequestFromService(s *Service) UpdateServiceRequest {
+	var techEmails *[]*ContactEmail
+	if len(s.TechnicalEmails) > 0 {
+		techEmails = &s.TechnicalEmails
+	}
+
+	return UpdateServiceRequest{
+		Cloud:                 s.CloudName,
+		MaintenanceWindow:     s.MaintenanceWindow.schedule(),
+		Plan:                  s.Plan,
+		ProjectVPCID:          s.ProjectVPCID,
+		Powered:               s.Powered,
+		TerminationProtection: s.TerminationProtection,
+		DiskSpaceMB:           s.DiskSpaceMB,
+		TechnicalEmails:       techEmails,
+	}
+}
+
+// updateUserConfig sets the given user config keys of the service. Aiven merges the keys
+// into the existing user config, so the other keys are left untouched.
+func (h *ServicesHandler) updateUserConfig(project string, s *Service, uc map[string]interface{}) (*Service, error) {
+	req := updateRequestFromService(s)
+	req.UserConfig = uc
+
+	return h.Update(project, s.Name, req)
+}
+
+// mergeUserConfigObject returns the object of the user config key with the given values set.
+// Only the top level user config keys are merged by Aiven, so the other settings of the
+// object must be sent along to keep them.
+func mergeUserConfigObject(userConfig map[string]interface{}, key string, values map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+	if current, ok := userConfig[key].(map[string]interface{}); ok {
+		for k, v := range current {
+			merged[k] = v
+		}
+	}
+
+	for k, v := range values {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+// Migrate moves the service to another cloud or region. The target cloud must be available
+// to the project. When wait is greater than zero Migrate polls the service until the
+// migration completes or the wait time is exceeded. The service may still look running
+// right after the update, so the migration is only complete once the service has left the
+// running state or got new nodes, and is running again in the target cloud.
+func (h *ServicesHandler) Migrate(project, service, cloudName string, wait time.Duration) (*Service, error) {
+	clouds, err := h.client.Projects.ListClouds(project)
+	if err != nil {
+		return nil, err
+	}
+
+	var available bool
+	for _, c := range clouds {
+		if c.Name == cloudName {
+			available = true
+			break
+		}
+	}
+	if !available {
+		return nil, fmt.Errorf("cloud %s is not available to project %s", cloudName, project)
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := nodeNames(s)
+	req := updateRequestFromService(s)
+	req.Cloud = cloudName
+	s, err = h.Update(project, service, req)
+	if err != nil || wait <= 0 {
+		return s, err
+	}
+
+	var started bool
+	s, done, err := h.waitFor(project, service, wait, func(s *Service) bool {
+		if !started {
+			started = s.State != ServiceStateRunning || nodeNames(s) != nodes
+		}
+		return started && s.CloudName == cloudName && s.State == ServiceStateRunning && nodesRunning(s)
+	})
+	if err == nil && !done {
+		err = fmt.Errorf("service %s migration to %s did not complete within %s", service, cloudName, wait)
+	}
+
+	return s, err
+}
+
+// PowerOn powers on the service, e.g. after parking it with PowerOff.
+func (h *ServicesHandler) PowerOn(project, service string) (*Service, error) {
+	return h.setPowered(project, service, true)
+}
+
+// PowerOff powers off the service. The data of the service is kept in its backups and
+// restored when it is powered on again, data written after the latest backup is lost.
+func (h *ServicesHandler) PowerOff(project, service string) (*Service, error) {
+	return h.setPowered(project, service, false)
+}
+
+func (h *ServicesHandler) setPowered(project, service string, powered bool) (*Service, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Powered == powered {
+		return s, nil
+	}
+
+	if s.State == ServiceStateRebuilding {
+		return nil, fmt.Errorf("service %s is %s, wait for it to complete before powering it on or off", service, s.State)
+	}
+
+	req := updateRequestFromService(s)
+	req.Powered = powered
+
+	return h.Update(project, service, req)
+}
+
+// MoveToVPC moves the service into the project VPC. The VPC must be active and in the cloud
+// the service runs in.
+func (h *ServicesHandler) MoveToVPC(project, service, vpcID string) (*Service, error) {
+	vpc, err := h.client.VPCs.Get(project, vpcID)
+	if err != nil {
+		return nil, err
+	}
+
+	if vpc.State != "ACTIVE" {
+		return nil, fmt.Errorf("project VPC %s is %s, not ACTIVE", vpcID, vpc.State)
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if vpc.CloudName != s.CloudName {
+		return nil, fmt.Errorf("project VPC %s is in cloud %s but service %s is in %s", vpcID, vpc.CloudName, service, s.CloudName)
+	}
+
+	req := updateRequestFromService(s)
+	req.ProjectVPCID = &vpcID
+
+	return h.Update(project, service, req)
+}
+
+// MoveToPublic moves the service out of its project VPC to the public network of its cloud.
+func (h *ServicesHandler) MoveToPublic(project, service string) (*Service, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.ProjectVPCID == nil {
+		return s, nil
+	}
+
+	req := updateRequestFromService(s)
+	req.ProjectVPCID = nil
+
+	return h.Update(project, service, req)
+}
+
+// waitFor polls the service until the condition is met or the wait time is exceeded,
+// in which case the last state of the service is returned with done set to false
+func (h *ServicesHandler) waitFor(
+	project, service string,
+	wait time.Duration,
+	condition func(s *Service) bool,
+	opts ...WaitOption,
+) (s *Service, done bool, err error) {
+	o := waitOptions{pollInterval: servicePollInterval, backoff: 1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	interval := o.pollInterval
+	deadline := time.Now().Add(wait)
+	for {
+		s, err = h.Get(project, service)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if condition(s) {
+			return s, true, nil
+		}
+
+		if time.Now().After(deadline) {
+			return s, false, nil
+		}
+
+		if err := h.client.wait(interval); err != nil {
+			return s, false, err
+		}
+		interval = o.next(interval)
+	}
+}
+
+// WithPollInterval sets how often the service is polled while waiting for it,
+// the default is 10 seconds
+func WithPollInterval(d time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.pollInterval = d
+	}
+}
+
+// WithBackoff multiplies the poll interval by factor after each poll, up to max.
+// A max of zero doesn't limit the interval.
+func WithBackoff(factor float64, max time.Duration) WaitOption {
+	return func(o *waitOptions) {
+		o.backoff = factor
+		o.maxInterval = max
+	}
+}
+
+// next returns the poll interval following the given one
+func (o waitOptions) next(interval time.Duration) time.Duration {
+	if o.backoff <= 1 {
+		return interval
+	}
+
+	next := time.Duration(float64(interval) * o.backoff)
+	if o.maxInterval > 0 && next > o.maxInterval {
+		return o.maxInterval
+	}
+	return next
+}
+
+// WaitUntilRunning polls the service until it is running, e.g. after it has been created or
+// powered on, or the timeout is exceeded. A powered off service never starts running on its
+// own, so waiting for it fails right away. The polling is configured with WithPollInterval
+// and WithBackoff. Waiting is cancelled with the context of the client, see Client.WithContext.
+func (h *ServicesHandler) WaitUntilRunning(
+	project, service string,
+	timeout time.Duration,
+	opts ...WaitOption,
+) (*Service, error) {
+	s, done, err := h.waitFor(project, service, timeout, func(s *Service) bool {
+		return s.State == ServiceStateRunning || s.State == ServiceStatePoweroff
+	}, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if !done {
+		return s, fmt.Errorf("service %s was not running within %s, it is %s", service, timeout, s.State)
+	}
+
+	if s.State == ServiceStatePoweroff {
+		return s, fmt.Errorf("service %s is powered off", service)
+	}
+
+	return s, nil
+}
+
+// nodeNames returns the sorted names of the nodes of the service, joined to compare them
+func nodeNames(s *Service) string {
+	names := make([]string, 0, len(s.NodeStates))
+	for _, n := range s.NodeStates {
+		names = append(names, n.Name)
+	}
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
+
+// nodesRunning returns true if all the nodes of the service are running
+func nodesRunning(s *Service) bool {
+	for _, n := range s.NodeStates {
+		if n.State != "running" {
+			return false
+		}
+	}
+	return true
+}
+
+// EstimatedCost returns the current run-rate of the service based on its plan
+// pricing and any additional disk space. Powered off services cost nothing.
+func (h *ServicesHandler) EstimatedCost(project, service string) (*CostEstimate, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.estimatedCost(project, s)
+}
+
+func (h *ServicesHandler) estimatedCost(project string, s *Service) (*CostEstimate, error) {
+	e := &CostEstimate{Currency: "USD"}
+	if !s.Powered {
+		return e, nil
+	}
+
+	pricing, err := h.client.ServiceTypes.GetPlanPricing(project, s.Type, s.Plan, s.CloudName)
+	if err != nil {
+		return nil, err
+	}
+
+	e.HourlyPrice, err = strconv.ParseFloat(pricing.BasePriceUSD, 64)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse base price %q: %w", pricing.BasePriceUSD, err)
+	}
+
+	if s.DiskSpaceMB > 0 && pricing.ExtraDiskPricePerGBUSD != "" {
+		plan, err := h.client.ServiceTypes.GetPlan(project, s.Type, s.Plan)
+		if err != nil {
+			return nil, err
+		}
+
+		if extraMB := s.DiskSpaceMB - plan.DiskSpaceMB; extraMB > 0 {
+			perGB, err := strconv.ParseFloat(pricing.ExtraDiskPricePerGBUSD, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse extra disk price %q: %w", pricing.ExtraDiskPricePerGBUSD, err)
+			}
+			e.HourlyPrice += perGB * float64(extraMB) / 1024
+		}
+	}
+
+	e.MonthlyPrice = e.HourlyPrice * hoursPerMonth
+
+	return e, nil
+}
+
+// DiskSpacePriceQuote returns the change of the service run-rate when its disk space is
+// resized to the given size. The size must be within the plan limits and a multiple of its step.
+func (h *ServicesHandler) DiskSpacePriceQuote(project, service string, diskSpaceMB int) (*CostEstimate, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := h.client.ServiceTypes.GetPlan(project, s.Type, s.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	if diskSpaceMB < plan.DiskSpaceMB || (plan.DiskSpaceCapMB > 0 && diskSpaceMB > plan.DiskSpaceCapMB) {
+		return nil, fmt.Errorf("disk space %d MB is outside of plan %s limits %d-%d MB",
+			diskSpaceMB, s.Plan, plan.DiskSpaceMB, plan.DiskSpaceCapMB)
+	}
+
+	if plan.DiskSpaceStepMB > 0 && (diskSpaceMB-plan.DiskSpaceMB)%plan.DiskSpaceStepMB != 0 {
+		return nil, fmt.Errorf("disk space %d MB is not a multiple of plan %s step %d MB", diskSpaceMB, s.Plan, plan.DiskSpaceStepMB)
+	}
+
+	pricing, err := h.client.ServiceTypes.GetPlanPricing(project, s.Type, s.Plan, s.CloudName)
+	if err != nil {
+		return nil, err
+	}
+
+	perGB, err := strconv.ParseFloat(pricing.ExtraDiskPricePerGBUSD, 64)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse extra disk price %q: %w", pricing.ExtraDiskPricePerGBUSD, err)
+	}
+
+	current := s.DiskSpaceMB
+	if current == 0 {
+		current = plan.DiskSpaceMB
+	}
+
+	e := &CostEstimate{Currency: "USD"}
+	e.HourlyPrice = perGB * float64(diskSpaceMB-current) / 1024
+	e.MonthlyPrice = e.HourlyPrice * hoursPerMonth
+
+	return e, nil
+}
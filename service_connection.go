@@ -0,0 +1,136 @@
+package aiven
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+)
+
+// pgSSLRequestCode asks a PostgreSQL server to switch the connection to TLS
+const pgSSLRequestCode = 80877103
+
+// connectionTestTimeout limits a connection test when the context has no deadline
+var connectionTestTimeout = 30 * time.Second
+
+// ConnectionTestResult is the outcome of a successful service connection test
+type ConnectionTestResult struct {
+	Host string
+	Port string
+	// TLS is true if a TLS handshake verified against the project CA was completed
+	TLS     bool
+	Latency time.Duration
+}
+
+// TestConnection checks that the service accepts connections by opening a TCP connection
+// to its primary host and completing a TLS handshake verified against the project CA.
+// The primary user's client certificate is presented when the service has one, e.g. for
+// Kafka. PostgreSQL is asked to switch to TLS first, while MySQL, which negotiates TLS
+// within its protocol, is only checked for TCP connectivity. Without a deadline on the
+// context the test times out after 30 seconds.
+func (h *ServicesHandler) TestConnection(ctx context.Context, project, service string) (*ConnectionTestResult, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	host, port := s.URIParams["host"], s.URIParams["port"]
+	if host == "" || port == "" {
+		return nil, fmt.Errorf("service %s has no host and port to connect to", service)
+	}
+
+	var tlsConfig *tls.Config
+	if s.Type != "mysql" {
+		ca, err := h.client.CA.Get(project)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsConfig, err = connectionTLSConfig(host, ca, s.Users)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	start := time.Now()
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = start.Add(connectionTestTimeout)
+	}
+
+	d := net.Dialer{Deadline: deadline}
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	result := &ConnectionTestResult{Host: host, Port: port}
+	if tlsConfig != nil {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return nil, err
+		}
+
+		if s.Type == "pg" {
+			if err := pgRequestSSL(conn); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := tls.Client(conn, tlsConfig).Handshake(); err != nil {
+			return nil, fmt.Errorf("tls handshake with %s failed: %w", host, err)
+		}
+		result.TLS = true
+	}
+	result.Latency = time.Since(start)
+
+	return result, nil
+}
+
+// connectionTLSConfig trusts the project CA and presents the client certificate of the primary user if any
+func connectionTLSConfig(host, ca string, users []*ServiceUser) (*tls.Config, error) {
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(ca)) {
+		return nil, errors.New("cannot parse the project CA certificate")
+	}
+
+	c := &tls.Config{ServerName: host, RootCAs: pool}
+	for _, u := range users {
+		if u.Type != "primary" || u.AccessCert == "" {
+			continue
+		}
+
+		cert, err := tls.X509KeyPair([]byte(u.AccessCert), []byte(u.AccessKey))
+		if err != nil {
+			return nil, fmt.Errorf("cannot load the client certificate of user %s: %w", u.Username, err)
+		}
+		c.Certificates = []tls.Certificate{cert}
+	}
+
+	return c, nil
+}
+
+// pgRequestSSL sends the PostgreSQL SSLRequest message and checks that the server accepts it
+func pgRequestSSL(conn net.Conn) error {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint32(msg[0:4], 8)
+	binary.BigEndian.PutUint32(msg[4:8], pgSSLRequestCode)
+	if _, err := conn.Write(msg); err != nil {
+		return err
+	}
+
+	rsp := make([]byte, 1)
+	if _, err := conn.Read(rsp); err != nil {
+		return err
+	}
+
+	if rsp[0] != 'S' {
+		return errors.New("postgresql server refused to switch to tls")
+	}
+
+	return nil
+}
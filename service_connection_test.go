@@ -0,0 +1,123 @@
+package aiven
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func Test_pgRequestSSL(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		reply   byte
+		wantErr bool
+	}{
+		{"accepted", 'S', false},
+		{"refused", 'N', true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client, server := net.Pipe()
+			defer client.Close()
+			defer server.Close()
+
+			go func() {
+				msg := make([]byte, 8)
+				if _, err := server.Read(msg); err != nil {
+					t.Error(err)
+					return
+				}
+				if binary.BigEndian.Uint32(msg[4:8]) != pgSSLRequestCode {
+					t.Errorf("unexpected request code %v", msg[4:8])
+				}
+				if _, err := server.Write([]byte{tt.reply}); err != nil {
+					t.Error(err)
+				}
+			}()
+
+			if err := pgRequestSSL(client); (err != nil) != tt.wantErr {
+				t.Errorf("pgRequestSSL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServicesHandler_TestConnection(t *testing.T) {
+	timeout := connectionTestTimeout
+	connectionTestTimeout = 100 * time.Millisecond
+	defer func() { connectionTestTimeout = timeout }()
+
+	// The TLS server stands in for a service, its certificate is the project CA
+	tlsServer := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer tlsServer.Close()
+	ca := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tlsServer.Certificate().Raw})
+
+	// A server which accepts connections but never completes the handshake
+	silent, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer silent.Close()
+	go func() {
+		for {
+			conn, err := silent.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	tests := []struct {
+		name    string
+		addr    string
+		wantErr bool
+	}{
+		{"handshake", tlsServer.Listener.Addr().String(), false},
+		{"no-handshake", silent.Addr().String(), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			host, port, err := net.SplitHostPort(tt.addr)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var rsp interface{}
+				switch r.URL.Path {
+				case "/project/test-pr/kms/ca":
+					rsp = ProjectCAResponse{CACertificate: string(ca)}
+				default:
+					rsp = ServiceResponse{Service: &Service{
+						Name: "my-kafka", Type: "kafka", URIParams: map[string]string{"host": host, "port": port},
+					}}
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(rsp); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer ts.Close()
+
+			apiurl = ts.URL
+			c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+			c.Init()
+
+			got, err := c.Services.TestConnection(context.Background(), "test-pr", "my-kafka")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TestConnection() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && (!got.TLS || got.Host != host) {
+				t.Errorf("TestConnection() got = %+v", got)
+			}
+		})
+	}
+}
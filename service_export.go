@@ -0,0 +1,198 @@
+package aiven
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServiceExport is the definition of a service, its integrations with other services, users,
+// databases and Kafka topics. It can be serialized and imported to recreate the service.
+// Credentials and data are not part of the export.
+type ServiceExport struct {
+	ServiceType           string                    `json:"service_type"`
+	Plan                  string                    `json:"plan"`
+	Cloud                 string                    `json:"cloud"`
+	DiskSpaceMB           int                       `json:"disk_space_mb,omitempty"`
+	MaintenanceWindow     *MaintenanceWindow        `json:"maintenance,omitempty"`
+	TerminationProtection bool                      `json:"termination_protection"`
+	UserConfig            map[string]interface{}    `json:"user_config,omitempty"`
+	Tags                  map[string]string         `json:"tags,omitempty"`
+	Integrations          []NewServiceIntegration   `json:"service_integrations,omitempty"`
+	Users                 []string                  `json:"users,omitempty"`
+	Databases             []CreateDatabaseRequest   `json:"databases,omitempty"`
+	Topics                []CreateKafkaTopicRequest `json:"topics,omitempty"`
+}
+
+// Export returns the definition of the service. Integrations refer to the exported service
+// by an empty name, which Import replaces with the name of the imported service. The requests
+// are cancelled with the context of the client, see Client.WithContext.
+func (h *ServicesHandler) Export(project, service string) (*ServiceExport, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &ServiceExport{
+		ServiceType:           s.Type,
+		Plan:                  s.Plan,
+		Cloud:                 s.CloudName,
+		DiskSpaceMB:           s.DiskSpaceMB,
+		MaintenanceWindow:     s.MaintenanceWindow.schedule(),
+		TerminationProtection: s.TerminationProtection,
+		UserConfig:            s.UserConfig,
+		Tags:                  s.Tags,
+	}
+
+	for _, i := range s.Integrations {
+		e.Integrations = append(e.Integrations, NewServiceIntegration{
+			DestinationEndpointID: i.DestinationEndpointID,
+			DestinationService:    exportServiceName(i.DestinationService, service),
+			IntegrationType:       i.IntegrationType,
+			SourceService:         exportServiceName(i.SourceService, service),
+			SourceEndpointID:      i.SourceEndpointID,
+			UserConfig:            i.UserConfig,
+		})
+	}
+
+	for _, u := range s.Users {
+		if u.Type != "primary" {
+			e.Users = append(e.Users, u.Username)
+		}
+	}
+
+	switch s.Type {
+	case "pg", "mysql":
+		databases, err := h.client.Databases.List(project, service)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, d := range databases {
+			e.Databases = append(e.Databases, CreateDatabaseRequest{
+				Database:  d.DatabaseName,
+				LcCollate: d.LcCollate,
+				LcType:    d.LcType,
+			})
+		}
+	case "kafka":
+		topics, err := h.client.KafkaTopics.List(project, service)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range topics {
+			e.Topics = append(e.Topics, exportKafkaTopic(t))
+		}
+	}
+
+	return e, nil
+}
+
+// Import creates the service from the export and waits up to the given time for it to be
+// running before creating its integrations, users, databases and topics. Those which already
+// exist are left as they are. The requests and waiting are cancelled with the context of the
+// client, see Client.WithContext.
+func (h *ServicesHandler) Import(project, service string, e *ServiceExport, wait time.Duration) (*Service, error) {
+	s, err := h.Create(project, CreateServiceRequest{
+		Cloud:                 e.Cloud,
+		DiskSpaceMB:           e.DiskSpaceMB,
+		MaintenanceWindow:     e.MaintenanceWindow,
+		Plan:                  e.Plan,
+		ServiceName:           service,
+		ServiceType:           e.ServiceType,
+		Tags:                  e.Tags,
+		TerminationProtection: e.TerminationProtection,
+		UserConfig:            e.UserConfig,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s, done, err := h.waitFor(project, service, wait, func(s *Service) bool {
+		return s.State == ServiceStateRunning
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !done {
+		return s, fmt.Errorf("service %s was not running within %s", service, wait)
+	}
+
+	for _, i := range e.Integrations {
+		_, err := h.client.ServiceIntegrations.Create(project, CreateServiceIntegrationRequest{
+			DestinationEndpointID: i.DestinationEndpointID,
+			DestinationService:    importServiceName(i.DestinationService, service),
+			IntegrationType:       i.IntegrationType,
+			SourceService:         importServiceName(i.SourceService, service),
+			SourceEndpointID:      i.SourceEndpointID,
+			UserConfig:            i.UserConfig,
+		})
+		if err != nil && !IsAlreadyExists(err) {
+			return s, err
+		}
+	}
+
+	for _, u := range e.Users {
+		_, err := h.client.ServiceUsers.Create(project, service, CreateServiceUserRequest{Username: u})
+		if err != nil && !IsAlreadyExists(err) {
+			return s, err
+		}
+	}
+
+	for _, d := range e.Databases {
+		if _, err := h.client.Databases.Create(project, service, d); err != nil && !IsAlreadyExists(err) {
+			return s, err
+		}
+	}
+
+	for _, t := range e.Topics {
+		if err := h.client.KafkaTopics.Create(project, service, t); err != nil && !IsAlreadyExists(err) {
+			return s, err
+		}
+	}
+
+	return h.Get(project, service)
+}
+
+// exportServiceName blanks the name of the exported service
+func exportServiceName(name *string, service string) *string {
+	if name != nil && *name == service {
+		return ToStringPointer("")
+	}
+	return name
+}
+
+// importServiceName replaces the blanked name of the exported service with the imported one
+func importServiceName(name *string, service string) *string {
+	if name != nil && *name == "" {
+		return &service
+	}
+	return name
+}
+
+func exportKafkaTopic(t *KafkaListTopic) CreateKafkaTopicRequest {
+	partitions, replication, minISR := t.Partitions, t.Replication, t.MinimumInSyncReplicas
+	retentionBytes := int64(t.RetentionBytes)
+
+	req := CreateKafkaTopicRequest{
+		TopicName:             t.TopicName,
+		Partitions:            &partitions,
+		Replication:           &replication,
+		MinimumInSyncReplicas: &minISR,
+		Config: KafkaTopicConfig{
+			CleanupPolicy:  t.CleanupPolicy,
+			RetentionBytes: &retentionBytes,
+		},
+		Tags: t.Tags,
+	}
+
+	if t.RetentionHours != nil {
+		retentionMs := *t.RetentionHours * 60 * 60 * 1000
+		if *t.RetentionHours == retentionInfinite {
+			retentionMs = retentionInfinite
+		}
+		req.Config.RetentionMs = &retentionMs
+	}
+
+	return req
+}
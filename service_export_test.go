@@ -0,0 +1,132 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_exportKafkaTopic(t *testing.T) {
+	hours := int64(24)
+	got := exportKafkaTopic(&KafkaListTopic{
+		TopicName:      "events",
+		Partitions:     3,
+		Replication:    2,
+		RetentionBytes: -1,
+		RetentionHours: &hours,
+		CleanupPolicy:  "delete",
+	})
+
+	if got.TopicName != "events" || *got.Partitions != 3 || *got.Replication != 2 {
+		t.Errorf("exportKafkaTopic() got = %+v", got)
+	}
+
+	if *got.Config.RetentionMs != 86400000 || *got.Config.RetentionBytes != -1 {
+		t.Errorf("exportKafkaTopic() retention = %v ms, %v bytes", *got.Config.RetentionMs, *got.Config.RetentionBytes)
+	}
+
+	if err := got.Validate(); err != nil {
+		t.Errorf("exportKafkaTopic() returned an invalid request: %v", err)
+	}
+}
+
+func Test_exportServiceName(t *testing.T) {
+	other := "other-service"
+	exported := exportServiceName(ToStringPointer("test-service"), "test-service")
+
+	if got := *importServiceName(exported, "new-service"); got != "new-service" {
+		t.Errorf("importServiceName() = %v, want %v", got, "new-service")
+	}
+
+	if got := *importServiceName(exportServiceName(&other, "test-service"), "new-service"); got != other {
+		t.Errorf("importServiceName() = %v, want %v", got, other)
+	}
+
+	if got := importServiceName(exportServiceName(nil, "test-service"), "new-service"); got != nil {
+		t.Errorf("importServiceName() = %v, want nil", got)
+	}
+}
+
+func TestServicesHandler_ExportImport(t *testing.T) {
+	var integrationSources, users, databases []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var rsp interface{}
+		switch r.Method + " " + r.URL.Path {
+		case "GET /project/test-pr/service/old-pg":
+			rsp = ServiceResponse{Service: &Service{
+				Name: "old-pg", Type: "pg", Plan: "startup-4", CloudName: "google-europe-west1", State: ServiceStateRunning,
+				Users: []*ServiceUser{{Username: "avnadmin", Type: "primary"}, {Username: "app", Type: "normal"}},
+				Integrations: []*ServiceIntegration{{
+					IntegrationType:    IntegrationTypeMetrics,
+					SourceService:      ToStringPointer("old-pg"),
+					DestinationService: ToStringPointer("metrics-pg"),
+				}},
+			}}
+		case "GET /project/test-pr/service/old-pg/db":
+			rsp = DatabaseListResponse{Databases: []*Database{{DatabaseName: "app"}}}
+		case "POST /project/test-pr/service", "GET /project/test-pr/service/new-pg":
+			rsp = ServiceResponse{Service: &Service{Name: "new-pg", Type: "pg", State: ServiceStateRunning}}
+		case "POST /project/test-pr/integration":
+			var req CreateServiceIntegrationRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			integrationSources = append(integrationSources, *req.SourceService)
+			rsp = ServiceIntegrationResponse{ServiceIntegration: &ServiceIntegration{IntegrationType: req.IntegrationType}}
+		case "POST /project/test-pr/service/new-pg/user":
+			var req CreateServiceUserRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			users = append(users, req.Username)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			_, _ = w.Write([]byte(`{"message": "Service user already exists"}`))
+			return
+		case "POST /project/test-pr/service/new-pg/db":
+			var req CreateDatabaseRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			databases = append(databases, req.Database)
+			rsp = APIResponse{}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(rsp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	e, err := c.Services.Export("test-pr", "old-pg")
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(e.Users, []string{"app"}) || len(e.Databases) != 1 || *e.Integrations[0].SourceService != "" {
+		t.Errorf("Export() got = %+v", e)
+	}
+
+	if _, err := c.Services.Import("test-pr", "new-pg", e, time.Minute); err != nil {
+		t.Fatalf("Import() error = %v", err)
+	}
+
+	if !reflect.DeepEqual(integrationSources, []string{"new-pg"}) {
+		t.Errorf("Import() got integration sources = %v, want [new-pg]", integrationSources)
+	}
+
+	if !reflect.DeepEqual(users, []string{"app"}) || !reflect.DeepEqual(databases, []string{"app"}) {
+		t.Errorf("Import() got users = %v, databases = %v", users, databases)
+	}
+}
@@ -36,6 +36,16 @@ type (
 		UserConfig              map[string]interface{} `json:"user_config"`
 	}
 
+	// ServiceIntegrationType represents an integration type and the service types it can connect
+	ServiceIntegrationType struct {
+		IntegrationType         string           `json:"integration_type"`
+		SourceDescription       string           `json:"source_description"`
+		SourceServiceTypes      []string         `json:"source_service_types"`
+		DestinationDescription  string           `json:"dest_description"`
+		DestinationServiceTypes []string         `json:"dest_service_types"`
+		UserConfigSchema        UserConfigSchema `json:"user_config_schema"`
+	}
+
 	// ServiceIntegrationsHandler is the client that interacts
 	// with the Service Integration Endpoints API endpoints on Aiven.
 	ServiceIntegrationsHandler struct {
@@ -43,10 +53,16 @@ type (
 	}
 
 	// CreateServiceIntegrationRequest are the parameters to create a Service Integration.
+	// Services are referenced by name regardless of the cloud they run in, so integrating
+	// services in different regions needs no extra parameters. SourceProject and
+	// DestinationProject target a service in another project, which defaults to the
+	// project the integration is created in.
 	CreateServiceIntegrationRequest struct {
+		DestinationProject    *string                `json:"dest_project,omitempty"`
 		DestinationService    *string                `json:"dest_service,omitempty"`
 		DestinationEndpointID *string                `json:"dest_endpoint_id,omitempty"`
 		IntegrationType       string                 `json:"integration_type"`
+		SourceProject         *string                `json:"source_project,omitempty"`
 		SourceService         *string                `json:"source_service,omitempty"`
 		SourceEndpointID      *string                `json:"source_endpoint_id,omitempty"`
 		UserConfig            map[string]interface{} `json:"user_config,omitempty"`
@@ -70,6 +86,13 @@ type (
 		APIResponse
 		ServiceIntegrations []*ServiceIntegration `json:"service_integrations"`
 	}
+
+	// ServiceIntegrationTypesResponse represents the response from Aiven
+	// for listing service integration types.
+	ServiceIntegrationTypesResponse struct {
+		APIResponse
+		IntegrationTypes []*ServiceIntegrationType `json:"integration_types"`
+	}
 )
 
 // Create the given Service Integration on Aiven.
@@ -145,3 +168,41 @@ func (h *ServiceIntegrationsHandler) List(project, service string) ([]*ServiceIn
 
 	return r.ServiceIntegrations, errR
 }
+
+// ListAll lists all service integrations of a project. The integrations are collected
+// from the service list, which includes them, so a single request is made.
+func (h *ServiceIntegrationsHandler) ListAll(project string) ([]*ServiceIntegration, error) {
+	services, err := h.client.Services.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	// An integration between two services of the project is listed on both of them
+	seen := make(map[string]bool)
+	var integrations []*ServiceIntegration
+	for _, s := range services {
+		for _, i := range s.Integrations {
+			if seen[i.ServiceIntegrationID] {
+				continue
+			}
+			seen[i.ServiceIntegrationID] = true
+			integrations = append(integrations, i)
+		}
+	}
+
+	return integrations, nil
+}
+
+// ListTypes lists all service integration types available to the project.
+func (h *ServiceIntegrationsHandler) ListTypes(project string) ([]*ServiceIntegrationType, error) {
+	path := buildPath("project", project, "integration_types")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceIntegrationTypesResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.IntegrationTypes, errR
+}
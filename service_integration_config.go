@@ -0,0 +1,684 @@
+package aiven
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// IntegrationTypeAutoscaler attaches an autoscaler integration endpoint to a service
+	IntegrationTypeAutoscaler = "autoscaler"
+
+	// EndpointTypeAutoscaler is an integration endpoint which scales service disk space
+	EndpointTypeAutoscaler = "autoscaler"
+
+	// AutoscalingTypeDisk autoscales service disk space based on its usage
+	AutoscalingTypeDisk = "autoscale_disk"
+
+	// IntegrationTypeClickhouseKafka reads Kafka topics into ClickHouse tables
+	IntegrationTypeClickhouseKafka = "clickhouse_kafka"
+
+	// IntegrationTypeClickhousePostgreSQL exposes PostgreSQL databases in ClickHouse
+	IntegrationTypeClickhousePostgreSQL = "clickhouse_postgresql"
+
+	// IntegrationTypeClickhouseCredentials gives a ClickHouse service the credentials of an external ClickHouse endpoint
+	IntegrationTypeClickhouseCredentials = "clickhouse_credentials"
+
+	// IntegrationTypeFlink makes an Aiven service available as a Flink source or sink
+	IntegrationTypeFlink = "flink"
+
+	// IntegrationTypeFlinkExternalKafka makes an external Kafka endpoint available as a Flink source or sink
+	IntegrationTypeFlinkExternalKafka = "flink_external_kafka"
+
+	// IntegrationTypeFlinkExternalPostgreSQL makes an external PostgreSQL endpoint available as a Flink source or sink
+	IntegrationTypeFlinkExternalPostgreSQL = "flink_external_postgresql"
+
+	// IntegrationTypeDashboard makes a metrics service, e.g. InfluxDB or M3DB, a Grafana data source.
+	// The Grafana service is the source of the integration.
+	IntegrationTypeDashboard = "dashboard"
+
+	// IntegrationTypeDatasource makes a database service, e.g. PostgreSQL or OpenSearch, a Grafana
+	// data source. The Grafana service is the destination of the integration.
+	IntegrationTypeDatasource = "datasource"
+
+	// IntegrationTypeMetrics stores the metrics of a service in a PostgreSQL, InfluxDB or M3DB service
+	IntegrationTypeMetrics = "metrics"
+
+	// EndpointTypeExternalKafka is an integration endpoint of a Kafka cluster outside of Aiven
+	EndpointTypeExternalKafka = "external_kafka"
+
+	// EndpointTypeExternalPostgreSQL is an integration endpoint of a PostgreSQL server outside of Aiven
+	EndpointTypeExternalPostgreSQL = "external_postgresql"
+
+	// EndpointTypeExternalClickhouse is an integration endpoint of a ClickHouse server outside of Aiven
+	EndpointTypeExternalClickhouse = "external_clickhouse"
+
+	// EndpointTypeExternalAWSCloudwatchLogs is an integration endpoint shipping service logs to AWS CloudWatch
+	EndpointTypeExternalAWSCloudwatchLogs = "external_aws_cloudwatch_logs"
+
+	// EndpointTypeExternalAWSCloudwatchMetrics is an integration endpoint shipping service metrics to AWS CloudWatch
+	EndpointTypeExternalAWSCloudwatchMetrics = "external_aws_cloudwatch_metrics"
+)
+
+var (
+	// awsRegionPattern matches AWS region names, e.g. eu-west-1 or us-gov-east-1
+	awsRegionPattern = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]?)?-[a-z]+-[0-9]+$`)
+
+	// cloudwatchLogGroupPattern matches the CloudWatch log group names
+	cloudwatchLogGroupPattern = regexp.MustCompile(`^[.\-_/#A-Za-z0-9]{1,512}$`)
+
+	// metricsNamePattern matches the database and user names of the metrics integration
+	metricsNamePattern = regexp.MustCompile(`^[_A-Za-z0-9][-_A-Za-z0-9]{0,39}$`)
+)
+
+// externalKafkaSecurityProtocols are the protocols supported to connect to an external Kafka
+var externalKafkaSecurityProtocols = []string{"PLAINTEXT", "SSL", "SASL_PLAINTEXT", "SASL_SSL"}
+
+// clickhouseKafkaDataFormats are the message formats supported by the clickhouse_kafka integration
+var clickhouseKafkaDataFormats = []string{
+	"Avro", "AvroConfluent", "CSV", "JSONAsString", "JSONCompactEachRow", "JSONCompactStringsEachRow",
+	"JSONEachRow", "JSONStringsEachRow", "MsgPack", "Parquet", "RawBLOB", "TSKV", "TSV", "TabSeparated",
+}
+
+type (
+	// AutoscalerEndpointUserConfig is the user config of an autoscaler integration endpoint
+	AutoscalerEndpointUserConfig struct {
+		Autoscaling []AutoscalingConfig `json:"autoscaling"`
+	}
+
+	// AutoscalingConfig configures a single autoscaling rule
+	AutoscalingConfig struct {
+		// CapGB is the maximum total disk size in GB the service may grow to
+		CapGB int    `json:"cap_gb"`
+		Type  string `json:"type"`
+	}
+)
+
+// Validate checks that the autoscaler config can be accepted by Aiven
+func (c AutoscalerEndpointUserConfig) Validate() error {
+	if len(c.Autoscaling) == 0 {
+		return errors.New("autoscaler endpoint requires at least one autoscaling rule")
+	}
+
+	for _, a := range c.Autoscaling {
+		if a.Type != AutoscalingTypeDisk {
+			return errors.New("unsupported autoscaling type: " + a.Type)
+		}
+
+		if a.CapGB <= 0 {
+			return errors.New("autoscaling cap_gb must be greater than zero")
+		}
+	}
+
+	return nil
+}
+
+type (
+	// ClickhouseKafkaUserConfig is the user config of a clickhouse_kafka integration
+	ClickhouseKafkaUserConfig struct {
+		Tables []ClickhouseKafkaTable `json:"tables"`
+	}
+
+	// ClickhouseKafkaTable maps Kafka topics to a ClickHouse table
+	ClickhouseKafkaTable struct {
+		Name                string                  `json:"name"`
+		Columns             []ClickhouseKafkaColumn `json:"columns"`
+		Topics              []ClickhouseKafkaTopic  `json:"topics"`
+		DataFormat          string                  `json:"data_format"`
+		GroupName           string                  `json:"group_name"`
+		AutoOffsetReset     string                  `json:"auto_offset_reset,omitempty"`
+		DateTimeInputFormat string                  `json:"date_time_input_format,omitempty"`
+		HandleErrorMode     string                  `json:"handle_error_mode,omitempty"`
+		MaxBlockSize        *int                    `json:"max_block_size,omitempty"`
+		MaxRowsPerMessage   *int                    `json:"max_rows_per_message,omitempty"`
+		NumConsumers        *int                    `json:"num_consumers,omitempty"`
+		PollMaxBatchSize    *int                    `json:"poll_max_batch_size,omitempty"`
+		SkipBrokenMessages  *int                    `json:"skip_broken_messages,omitempty"`
+	}
+
+	// ClickhouseKafkaColumn is a column of a ClickHouse table read from Kafka
+	ClickhouseKafkaColumn struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	}
+
+	// ClickhouseKafkaTopic is a Kafka topic read into a ClickHouse table
+	ClickhouseKafkaTopic struct {
+		Name string `json:"name"`
+	}
+
+	// ClickhousePostgreSQLUserConfig is the user config of a clickhouse_postgresql integration
+	ClickhousePostgreSQLUserConfig struct {
+		Databases []ClickhousePostgreSQLDatabase `json:"databases,omitempty"`
+	}
+
+	// ClickhousePostgreSQLDatabase is a PostgreSQL database and schema exposed in ClickHouse
+	ClickhousePostgreSQLDatabase struct {
+		Database string `json:"database"`
+		Schema   string `json:"schema,omitempty"`
+	}
+)
+
+// Validate checks that the clickhouse_kafka config can be accepted by Aiven
+func (c ClickhouseKafkaUserConfig) Validate() error {
+	for _, t := range c.Tables {
+		if t.Name == "" {
+			return errors.New("clickhouse_kafka table name is required")
+		}
+
+		if len(t.Columns) == 0 {
+			return fmt.Errorf("clickhouse_kafka table %s requires at least one column", t.Name)
+		}
+
+		for _, col := range t.Columns {
+			if col.Name == "" || col.Type == "" {
+				return fmt.Errorf("clickhouse_kafka table %s columns require a name and a type", t.Name)
+			}
+		}
+
+		if len(t.Topics) == 0 {
+			return fmt.Errorf("clickhouse_kafka table %s requires at least one topic", t.Name)
+		}
+
+		if t.GroupName == "" {
+			return fmt.Errorf("clickhouse_kafka table %s requires a consumer group name", t.Name)
+		}
+
+		if !containsString(clickhouseKafkaDataFormats, t.DataFormat) {
+			return fmt.Errorf("clickhouse_kafka table %s has unsupported data format %q", t.Name, t.DataFormat)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the clickhouse_postgresql config can be accepted by Aiven
+func (c ClickhousePostgreSQLUserConfig) Validate() error {
+	for _, d := range c.Databases {
+		if d.Database == "" {
+			return errors.New("clickhouse_postgresql database name is required")
+		}
+	}
+
+	return nil
+}
+
+type (
+	// ExternalKafkaEndpointUserConfig is the user config of an external_kafka integration endpoint
+	ExternalKafkaEndpointUserConfig struct {
+		BootstrapServers                   string `json:"bootstrap_servers"`
+		SecurityProtocol                   string `json:"security_protocol"`
+		SASLMechanism                      string `json:"sasl_mechanism,omitempty"`
+		SASLPlainUsername                  string `json:"sasl_plain_username,omitempty"`
+		SASLPlainPassword                  string `json:"sasl_plain_password,omitempty"`
+		SSLCACert                          string `json:"ssl_ca_cert,omitempty"`
+		SSLClientCert                      string `json:"ssl_client_cert,omitempty"`
+		SSLClientKey                       string `json:"ssl_client_key,omitempty"`
+		SSLEndpointIdentificationAlgorithm string `json:"ssl_endpoint_identification_algorithm,omitempty"`
+	}
+
+	// ExternalPostgreSQLEndpointUserConfig is the user config of an external_postgresql integration endpoint
+	ExternalPostgreSQLEndpointUserConfig struct {
+		Host                 string `json:"host"`
+		Port                 int    `json:"port"`
+		Username             string `json:"username"`
+		Password             string `json:"password,omitempty"`
+		DefaultDatabase      string `json:"default_database,omitempty"`
+		SSLMode              string `json:"ssl_mode,omitempty"`
+		SSLRootCert          string `json:"ssl_root_cert,omitempty"`
+		SSLClientCertificate string `json:"ssl_client_certificate,omitempty"`
+		SSLClientKey         string `json:"ssl_client_key,omitempty"`
+	}
+
+	// ExternalClickhouseEndpointUserConfig is the user config of an external_clickhouse integration
+	// endpoint. It has no ssl field as the endpoint schema has no ssl setting.
+	ExternalClickhouseEndpointUserConfig struct {
+		Host     string `json:"host"`
+		Port     int    `json:"port"`
+		Username string `json:"username"`
+		Password string `json:"password,omitempty"`
+	}
+
+	// ExternalAWSCloudwatchLogsEndpointUserConfig is the user config of an
+	// external_aws_cloudwatch_logs integration endpoint
+	ExternalAWSCloudwatchLogsEndpointUserConfig struct {
+		AccessKey    string `json:"access_key"`
+		SecretKey    string `json:"secret_key"`
+		Region       string `json:"region"`
+		LogGroupName string `json:"log_group_name,omitempty"`
+	}
+
+	// ExternalAWSCloudwatchMetricsEndpointUserConfig is the user config of an
+	// external_aws_cloudwatch_metrics integration endpoint
+	ExternalAWSCloudwatchMetricsEndpointUserConfig struct {
+		AccessKey string `json:"access_key"`
+		SecretKey string `json:"secret_key"`
+		Region    string `json:"region"`
+		Namespace string `json:"namespace"`
+	}
+)
+
+// Validate checks that the external_kafka config can be accepted by Aiven
+func (c ExternalKafkaEndpointUserConfig) Validate() error {
+	if c.BootstrapServers == "" {
+		return errors.New("external_kafka bootstrap_servers is required")
+	}
+
+	if !containsString(externalKafkaSecurityProtocols, c.SecurityProtocol) {
+		return fmt.Errorf("external_kafka has unsupported security protocol %q", c.SecurityProtocol)
+	}
+
+	if (c.SecurityProtocol == "SASL_PLAINTEXT" || c.SecurityProtocol == "SASL_SSL") &&
+		(c.SASLMechanism == "" || c.SASLPlainUsername == "" || c.SASLPlainPassword == "") {
+		return fmt.Errorf("external_kafka security protocol %s requires a SASL mechanism, username and password", c.SecurityProtocol)
+	}
+
+	if (c.SSLClientCert == "") != (c.SSLClientKey == "") {
+		return errors.New("external_kafka ssl_client_cert and ssl_client_key must be set together")
+	}
+
+	return nil
+}
+
+// Validate checks that the external_postgresql config can be accepted by Aiven
+func (c ExternalPostgreSQLEndpointUserConfig) Validate() error {
+	if c.Host == "" || c.Username == "" {
+		return errors.New("external_postgresql host and username are required")
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		return fmt.Errorf("external_postgresql has invalid port %d", c.Port)
+	}
+
+	if (c.SSLClientCertificate == "") != (c.SSLClientKey == "") {
+		return errors.New("external_postgresql ssl_client_certificate and ssl_client_key must be set together")
+	}
+
+	return nil
+}
+
+// Validate checks that the external_clickhouse config can be accepted by Aiven. The host is
+// a plain host name, the scheme and port of a connection string are not accepted in it.
+func (c ExternalClickhouseEndpointUserConfig) Validate() error {
+	if c.Host == "" || c.Username == "" {
+		return errors.New("external_clickhouse host and username are required")
+	}
+
+	if strings.ContainsAny(c.Host, ":/@") {
+		return fmt.Errorf("external_clickhouse host %q must be a host name without scheme, port or credentials", c.Host)
+	}
+
+	if c.Port <= 0 || c.Port > 65535 {
+		return fmt.Errorf("external_clickhouse has invalid port %d", c.Port)
+	}
+
+	return nil
+}
+
+// Validate checks that the external_aws_cloudwatch_logs config can be accepted by Aiven
+func (c ExternalAWSCloudwatchLogsEndpointUserConfig) Validate() error {
+	if err := validateAWSCredentials("external_aws_cloudwatch_logs", c.AccessKey, c.SecretKey, c.Region); err != nil {
+		return err
+	}
+
+	if c.LogGroupName != "" && !cloudwatchLogGroupPattern.MatchString(c.LogGroupName) {
+		return fmt.Errorf("external_aws_cloudwatch_logs has invalid log group name %q", c.LogGroupName)
+	}
+
+	return nil
+}
+
+// Validate checks that the external_aws_cloudwatch_metrics config can be accepted by Aiven
+func (c ExternalAWSCloudwatchMetricsEndpointUserConfig) Validate() error {
+	if err := validateAWSCredentials("external_aws_cloudwatch_metrics", c.AccessKey, c.SecretKey, c.Region); err != nil {
+		return err
+	}
+
+	if c.Namespace == "" {
+		return errors.New("external_aws_cloudwatch_metrics namespace is required")
+	}
+
+	return nil
+}
+
+func validateAWSCredentials(endpointType, accessKey, secretKey, region string) error {
+	if accessKey == "" || secretKey == "" {
+		return fmt.Errorf("%s access_key and secret_key are required", endpointType)
+	}
+
+	if !awsRegionPattern.MatchString(region) {
+		return fmt.Errorf("%s has invalid AWS region %q", endpointType, region)
+	}
+
+	return nil
+}
+
+// toUserConfig converts a typed user config into its untyped map representation
+func toUserConfig(v interface{}) (map[string]interface{}, error) {
+	bts, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var m map[string]interface{}
+	if err := unmarshalJSON(bts, &m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// CreateAutoscaler creates an autoscaler integration endpoint with the given typed config.
+func (h *ServiceIntegrationEndpointsHandler) CreateAutoscaler(
+	project string,
+	endpointName string,
+	c AutoscalerEndpointUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	return h.createTyped(project, EndpointTypeAutoscaler, endpointName, c)
+}
+
+// CreateExternalKafka creates an integration endpoint of a Kafka cluster outside of Aiven.
+func (h *ServiceIntegrationEndpointsHandler) CreateExternalKafka(
+	project string,
+	endpointName string,
+	c ExternalKafkaEndpointUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	return h.createTyped(project, EndpointTypeExternalKafka, endpointName, c)
+}
+
+// CreateExternalPostgreSQL creates an integration endpoint of a PostgreSQL server outside of Aiven.
+func (h *ServiceIntegrationEndpointsHandler) CreateExternalPostgreSQL(
+	project string,
+	endpointName string,
+	c ExternalPostgreSQLEndpointUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	return h.createTyped(project, EndpointTypeExternalPostgreSQL, endpointName, c)
+}
+
+// CreateExternalClickhouse creates an integration endpoint of a ClickHouse server outside of Aiven.
+func (h *ServiceIntegrationEndpointsHandler) CreateExternalClickhouse(
+	project string,
+	endpointName string,
+	c ExternalClickhouseEndpointUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	return h.createTyped(project, EndpointTypeExternalClickhouse, endpointName, c)
+}
+
+// CreateExternalAWSCloudwatchLogs creates an integration endpoint shipping service logs to AWS CloudWatch.
+func (h *ServiceIntegrationEndpointsHandler) CreateExternalAWSCloudwatchLogs(
+	project string,
+	endpointName string,
+	c ExternalAWSCloudwatchLogsEndpointUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	return h.createTyped(project, EndpointTypeExternalAWSCloudwatchLogs, endpointName, c)
+}
+
+// CreateExternalAWSCloudwatchMetrics creates an integration endpoint shipping service metrics to AWS CloudWatch.
+func (h *ServiceIntegrationEndpointsHandler) CreateExternalAWSCloudwatchMetrics(
+	project string,
+	endpointName string,
+	c ExternalAWSCloudwatchMetricsEndpointUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	return h.createTyped(project, EndpointTypeExternalAWSCloudwatchMetrics, endpointName, c)
+}
+
+// createTyped validates the typed user config and creates an integration endpoint with it
+func (h *ServiceIntegrationEndpointsHandler) createTyped(
+	project, endpointType, endpointName string,
+	c typedUserConfig,
+) (*ServiceIntegrationEndpoint, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	userConfig, err := toUserConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Create(project, CreateServiceIntegrationEndpointRequest{
+		EndpointName: endpointName,
+		EndpointType: endpointType,
+		UserConfig:   userConfig,
+	})
+}
+
+// metricsMaxRetentionDays is the longest retention of the metrics integration
+const metricsMaxRetentionDays = 10000
+
+type (
+	// MetricsUserConfig is the user config of a metrics integration. Metrics are kept for
+	// 30 days unless RetentionDays is given.
+	MetricsUserConfig struct {
+		Database      string              `json:"database,omitempty"`
+		RetentionDays *int                `json:"retention_days,omitempty"`
+		Username      string              `json:"username,omitempty"`
+		ROUsername    string              `json:"ro_username,omitempty"`
+		SourceMySQL   *MetricsSourceMySQL `json:"source_mysql,omitempty"`
+	}
+
+	// MetricsSourceMySQL configures which metrics are collected from a MySQL source service
+	MetricsSourceMySQL struct {
+		Telegraf *MetricsMySQLTelegraf `json:"telegraf,omitempty"`
+	}
+
+	// MetricsMySQLTelegraf configures the Telegraf MySQL input of a metrics integration
+	MetricsMySQLTelegraf struct {
+		GatherEventWaits                    *bool `json:"gather_event_waits,omitempty"`
+		GatherFileEventsStats               *bool `json:"gather_file_events_stats,omitempty"`
+		GatherIndexIOWaits                  *bool `json:"gather_index_io_waits,omitempty"`
+		GatherInfoSchemaAutoInc             *bool `json:"gather_info_schema_auto_inc,omitempty"`
+		GatherInnodbMetrics                 *bool `json:"gather_innodb_metrics,omitempty"`
+		GatherPerfEventsStatements          *bool `json:"gather_perf_events_statements,omitempty"`
+		GatherProcessList                   *bool `json:"gather_process_list,omitempty"`
+		GatherSlaveStatus                   *bool `json:"gather_slave_status,omitempty"`
+		GatherTableIOWaits                  *bool `json:"gather_table_io_waits,omitempty"`
+		GatherTableLockWaits                *bool `json:"gather_table_lock_waits,omitempty"`
+		GatherTableSchema                   *bool `json:"gather_table_schema,omitempty"`
+		PerfEventsStatementsDigestTextLimit *int  `json:"perf_events_statements_digest_text_limit,omitempty"`
+		PerfEventsStatementsLimit           *int  `json:"perf_events_statements_limit,omitempty"`
+		PerfEventsStatementsTimeLimit       *int  `json:"perf_events_statements_time_limit,omitempty"`
+	}
+)
+
+// Validate checks that the metrics config can be accepted by Aiven
+func (c MetricsUserConfig) Validate() error {
+	if c.RetentionDays != nil && (*c.RetentionDays < 1 || *c.RetentionDays > metricsMaxRetentionDays) {
+		return fmt.Errorf("metrics retention_days must be between 1 and %d, got %d", metricsMaxRetentionDays, *c.RetentionDays)
+	}
+
+	for key, v := range map[string]string{"database": c.Database, "username": c.Username, "ro_username": c.ROUsername} {
+		if v != "" && !metricsNamePattern.MatchString(v) {
+			return fmt.Errorf("metrics %s %q is invalid, it must be at most 40 letters, digits, dashes or underscores", key, v)
+		}
+	}
+
+	return nil
+}
+
+// CreateMetrics stores the metrics of the service in a PostgreSQL, InfluxDB or M3DB service.
+func (h *ServiceIntegrationsHandler) CreateMetrics(
+	project, service, metricsService string,
+	c MetricsUserConfig,
+) (*ServiceIntegration, error) {
+	return h.createTyped(project, IntegrationTypeMetrics, service, metricsService, c)
+}
+
+// CreateAutoscaler attaches an autoscaler integration endpoint to the given service.
+func (h *ServiceIntegrationsHandler) CreateAutoscaler(project, service, endpointID string) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:       IntegrationTypeAutoscaler,
+		SourceService:         &service,
+		DestinationEndpointID: &endpointID,
+	})
+}
+
+// CreateClickhouseKafka integrates a Kafka service as a source of a ClickHouse service.
+func (h *ServiceIntegrationsHandler) CreateClickhouseKafka(
+	project, kafkaService, clickhouseService string,
+	c ClickhouseKafkaUserConfig,
+) (*ServiceIntegration, error) {
+	return h.createTyped(project, IntegrationTypeClickhouseKafka, kafkaService, clickhouseService, c)
+}
+
+// CreateClickhousePostgreSQL integrates a PostgreSQL service as a source of a ClickHouse service.
+func (h *ServiceIntegrationsHandler) CreateClickhousePostgreSQL(
+	project, pgService, clickhouseService string,
+	c ClickhousePostgreSQLUserConfig,
+) (*ServiceIntegration, error) {
+	return h.createTyped(project, IntegrationTypeClickhousePostgreSQL, pgService, clickhouseService, c)
+}
+
+// CreateFlink makes a Kafka, PostgreSQL or OpenSearch service available to a Flink service.
+func (h *ServiceIntegrationsHandler) CreateFlink(project, service, flinkService string) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    IntegrationTypeFlink,
+		SourceService:      &service,
+		DestinationService: &flinkService,
+	})
+}
+
+// CreateFlinkExternalKafka makes an external_kafka endpoint available to a Flink service.
+func (h *ServiceIntegrationsHandler) CreateFlinkExternalKafka(project, endpointID, flinkService string) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    IntegrationTypeFlinkExternalKafka,
+		SourceEndpointID:   &endpointID,
+		DestinationService: &flinkService,
+	})
+}
+
+// CreateFlinkExternalPostgreSQL makes an external_postgresql endpoint available to a Flink service.
+func (h *ServiceIntegrationsHandler) CreateFlinkExternalPostgreSQL(
+	project, endpointID, flinkService string,
+) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    IntegrationTypeFlinkExternalPostgreSQL,
+		SourceEndpointID:   &endpointID,
+		DestinationService: &flinkService,
+	})
+}
+
+// CreateClickhouseCredentials gives the ClickHouse service the credentials of an external_clickhouse endpoint.
+func (h *ServiceIntegrationsHandler) CreateClickhouseCredentials(
+	project, endpointID, clickhouseService string,
+) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    IntegrationTypeClickhouseCredentials,
+		SourceEndpointID:   &endpointID,
+		DestinationService: &clickhouseService,
+	})
+}
+
+// CreateGrafanaDashboard makes the metrics service a data source of the Grafana service.
+func (h *ServiceIntegrationsHandler) CreateGrafanaDashboard(project, grafanaService, metricsService string) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    IntegrationTypeDashboard,
+		SourceService:      &grafanaService,
+		DestinationService: &metricsService,
+	})
+}
+
+// CreateGrafanaDatasource makes the database service a data source of the Grafana service.
+func (h *ServiceIntegrationsHandler) CreateGrafanaDatasource(project, service, grafanaService string) (*ServiceIntegration, error) {
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    IntegrationTypeDatasource,
+		SourceService:      &service,
+		DestinationService: &grafanaService,
+	})
+}
+
+// ListGrafanaDatasources lists the dashboard and datasource integrations of the Grafana service.
+func (h *ServiceIntegrationsHandler) ListGrafanaDatasources(project, grafanaService string) ([]*ServiceIntegration, error) {
+	integrations, err := h.List(project, grafanaService)
+	if err != nil {
+		return nil, err
+	}
+
+	var datasources []*ServiceIntegration
+	for _, i := range integrations {
+		switch {
+		case i.IntegrationType == IntegrationTypeDashboard && i.SourceService != nil && *i.SourceService == grafanaService,
+			i.IntegrationType == IntegrationTypeDatasource && i.DestinationService != nil && *i.DestinationService == grafanaService:
+			datasources = append(datasources, i)
+		}
+	}
+
+	return datasources, nil
+}
+
+// typedUserConfig is implemented by typed integration user configs
+type typedUserConfig interface {
+	Validate() error
+}
+
+// createTyped validates the typed user config and creates a service to service integration with it
+func (h *ServiceIntegrationsHandler) createTyped(
+	project, integrationType, source, destination string,
+	c typedUserConfig,
+) (*ServiceIntegration, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	userConfig, err := toUserConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Create(project, CreateServiceIntegrationRequest{
+		IntegrationType:    integrationType,
+		SourceService:      &source,
+		DestinationService: &destination,
+		UserConfig:         userConfig,
+	})
+}
+
+// EnableDiskAutoscaling creates an autoscaler endpoint and attaches it to the service so its
+// disk space grows automatically up to maxDiskGB, the unit the autoscaler cap is given in.
+// The cap is checked against the disk space limits of the service plan. The endpoint is
+// removed again if it can't be attached.
+func (h *ServicesHandler) EnableDiskAutoscaling(project, service string, maxDiskGB int) (*ServiceIntegration, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := h.client.ServiceTypes.GetPlan(project, s.Type, s.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	current := s.DiskSpaceMB
+	if current == 0 {
+		current = plan.DiskSpaceMB
+	}
+
+	maxDiskMB := maxDiskGB * 1024
+	if maxDiskMB <= current {
+		return nil, fmt.Errorf("autoscaling cap of %d GB must exceed the current disk space of %d MB", maxDiskGB, current)
+	}
+
+	if plan.DiskSpaceCapMB > 0 && maxDiskMB > plan.DiskSpaceCapMB {
+		return nil, fmt.Errorf("autoscaling cap of %d GB exceeds the %d MB limit of plan %s", maxDiskGB, plan.DiskSpaceCapMB, s.Plan)
+	}
+
+	endpoint, err := h.client.ServiceIntegrationEndpoints.CreateAutoscaler(project, "autoscaler-"+service, AutoscalerEndpointUserConfig{
+		Autoscaling: []AutoscalingConfig{{CapGB: maxDiskGB, Type: AutoscalingTypeDisk}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	integration, err := h.client.ServiceIntegrations.CreateAutoscaler(project, service, endpoint.EndpointID)
+	if err != nil {
+		if errR := h.client.ServiceIntegrationEndpoints.Delete(project, endpoint.EndpointID); errR != nil {
+			return nil, fmt.Errorf("%w, removing the autoscaler endpoint failed: %s", err, errR)
+		}
+		return nil, err
+	}
+
+	return integration, nil
+}
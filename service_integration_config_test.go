@@ -0,0 +1,306 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAutoscalerEndpointUserConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  AutoscalerEndpointUserConfig
+		wantErr bool
+	}{
+		{
+			"normal",
+			AutoscalerEndpointUserConfig{Autoscaling: []AutoscalingConfig{{CapGB: 200, Type: AutoscalingTypeDisk}}},
+			false,
+		},
+		{
+			"empty",
+			AutoscalerEndpointUserConfig{},
+			true,
+		},
+		{
+			"zero-cap",
+			AutoscalerEndpointUserConfig{Autoscaling: []AutoscalingConfig{{CapGB: 0, Type: AutoscalingTypeDisk}}},
+			true,
+		},
+		{
+			"wrong-type",
+			AutoscalerEndpointUserConfig{Autoscaling: []AutoscalingConfig{{CapGB: 200, Type: "autoscale_cpu"}}},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClickhouseKafkaUserConfig_Validate(t *testing.T) {
+	table := func(modify func(t *ClickhouseKafkaTable)) ClickhouseKafkaUserConfig {
+		t := ClickhouseKafkaTable{
+			Name:       "events",
+			Columns:    []ClickhouseKafkaColumn{{Name: "id", Type: "UInt64"}},
+			Topics:     []ClickhouseKafkaTopic{{Name: "events"}},
+			DataFormat: "JSONEachRow",
+			GroupName:  "clickhouse",
+		}
+		modify(&t)
+		return ClickhouseKafkaUserConfig{Tables: []ClickhouseKafkaTable{t}}
+	}
+
+	tests := []struct {
+		name    string
+		config  ClickhouseKafkaUserConfig
+		wantErr bool
+	}{
+		{"normal", table(func(t *ClickhouseKafkaTable) {}), false},
+		{"no-tables", ClickhouseKafkaUserConfig{}, false},
+		{"no-name", table(func(t *ClickhouseKafkaTable) { t.Name = "" }), true},
+		{"no-columns", table(func(t *ClickhouseKafkaTable) { t.Columns = nil }), true},
+		{"column-without-type", table(func(t *ClickhouseKafkaTable) { t.Columns[0].Type = "" }), true},
+		{"no-topics", table(func(t *ClickhouseKafkaTable) { t.Topics = nil }), true},
+		{"no-group", table(func(t *ClickhouseKafkaTable) { t.GroupName = "" }), true},
+		{"wrong-format", table(func(t *ClickhouseKafkaTable) { t.DataFormat = "XML" }), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExternalKafkaEndpointUserConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ExternalKafkaEndpointUserConfig
+		wantErr bool
+	}{
+		{
+			"plaintext",
+			ExternalKafkaEndpointUserConfig{BootstrapServers: "kafka:9092", SecurityProtocol: "PLAINTEXT"},
+			false,
+		},
+		{
+			"sasl",
+			ExternalKafkaEndpointUserConfig{
+				BootstrapServers:  "kafka:9093",
+				SecurityProtocol:  "SASL_SSL",
+				SASLMechanism:     "SCRAM-SHA-256",
+				SASLPlainUsername: "flink",
+				SASLPlainPassword: "secret",
+			},
+			false,
+		},
+		{
+			"no-servers",
+			ExternalKafkaEndpointUserConfig{SecurityProtocol: "PLAINTEXT"},
+			true,
+		},
+		{
+			"wrong-protocol",
+			ExternalKafkaEndpointUserConfig{BootstrapServers: "kafka:9092", SecurityProtocol: "TLS"},
+			true,
+		},
+		{
+			"sasl-without-credentials",
+			ExternalKafkaEndpointUserConfig{BootstrapServers: "kafka:9093", SecurityProtocol: "SASL_SSL"},
+			true,
+		},
+		{
+			"cert-without-key",
+			ExternalKafkaEndpointUserConfig{BootstrapServers: "kafka:9093", SecurityProtocol: "SSL", SSLClientCert: "cert"},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExternalPostgreSQLEndpointUserConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ExternalPostgreSQLEndpointUserConfig
+		wantErr bool
+	}{
+		{"normal", ExternalPostgreSQLEndpointUserConfig{Host: "pg", Port: 5432, Username: "flink"}, false},
+		{"no-host", ExternalPostgreSQLEndpointUserConfig{Port: 5432, Username: "flink"}, true},
+		{"no-port", ExternalPostgreSQLEndpointUserConfig{Host: "pg", Username: "flink"}, true},
+		{
+			"key-without-cert",
+			ExternalPostgreSQLEndpointUserConfig{Host: "pg", Port: 5432, Username: "flink", SSLClientKey: "key"},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExternalAWSCloudwatchLogsEndpointUserConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ExternalAWSCloudwatchLogsEndpointUserConfig
+		wantErr bool
+	}{
+		{
+			"normal",
+			ExternalAWSCloudwatchLogsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Region: "eu-west-1", LogGroupName: "/aiven/pg"},
+			false,
+		},
+		{"gov-region", ExternalAWSCloudwatchLogsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Region: "us-gov-west-1"}, false},
+		{"no-credentials", ExternalAWSCloudwatchLogsEndpointUserConfig{Region: "eu-west-1"}, true},
+		{"invalid-region", ExternalAWSCloudwatchLogsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Region: "EU_WEST_1"}, true},
+		{
+			"invalid-log-group",
+			ExternalAWSCloudwatchLogsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Region: "eu-west-1", LogGroupName: "aiven logs"},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExternalAWSCloudwatchMetricsEndpointUserConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ExternalAWSCloudwatchMetricsEndpointUserConfig
+		wantErr bool
+	}{
+		{"normal", ExternalAWSCloudwatchMetricsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Region: "ap-southeast-2", Namespace: "aiven"}, false},
+		{"no-namespace", ExternalAWSCloudwatchMetricsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Region: "ap-southeast-2"}, true},
+		{"no-region", ExternalAWSCloudwatchMetricsEndpointUserConfig{AccessKey: "a", SecretKey: "s", Namespace: "aiven"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExternalClickhouseEndpointUserConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  ExternalClickhouseEndpointUserConfig
+		wantErr bool
+	}{
+		{"normal", ExternalClickhouseEndpointUserConfig{Host: "ch.example.com", Port: 9440, Username: "default"}, false},
+		{"no-username", ExternalClickhouseEndpointUserConfig{Host: "ch.example.com", Port: 9440}, true},
+		{"connection-string", ExternalClickhouseEndpointUserConfig{Host: "https://ch.example.com:8443", Port: 8443, Username: "default"}, true},
+		{"invalid-port", ExternalClickhouseEndpointUserConfig{Host: "ch.example.com", Port: 70000, Username: "default"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMetricsUserConfig_Validate(t *testing.T) {
+	i := func(v int) *int { return &v }
+
+	tests := []struct {
+		name    string
+		config  MetricsUserConfig
+		wantErr bool
+	}{
+		{"empty", MetricsUserConfig{}, false},
+		{"normal", MetricsUserConfig{Database: "metrics", RetentionDays: i(365), Username: "metrics_writer"}, false},
+		{"zero-retention", MetricsUserConfig{RetentionDays: i(0)}, true},
+		{"too-long-retention", MetricsUserConfig{RetentionDays: i(10001)}, true},
+		{"invalid-database", MetricsUserConfig{Database: "long-term metrics"}, true},
+		{"too-long-username", MetricsUserConfig{ROUsername: strings.Repeat("u", 41)}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServicesHandler_EnableDiskAutoscaling(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxDiskGB int
+		wantErr   bool
+	}{
+		{"normal", 200, false},
+		{"current", 80, true},
+		{"below-1gb-step", 0, true},
+		{"above-plan-cap", 500, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capGB interface{}
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var rsp interface{}
+				switch {
+				case strings.HasSuffix(r.URL.Path, "/service/my-pg"):
+					rsp = ServiceResponse{Service: &Service{Name: "my-pg", Type: "pg", Plan: "startup-4", DiskSpaceMB: 80 * 1024}}
+				case strings.HasSuffix(r.URL.Path, "/plans/startup-4"):
+					rsp = GetServicePlanResponse{DiskSpaceMB: 80 * 1024, DiskSpaceCapMB: 400 * 1024}
+				case strings.HasSuffix(r.URL.Path, "/integration_endpoint"):
+					var req CreateServiceIntegrationEndpointRequest
+					if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+						t.Error(err)
+					}
+					capGB = req.UserConfig["autoscaling"].([]interface{})[0].(map[string]interface{})["cap_gb"]
+					rsp = ServiceIntegrationEndpointResponse{ServiceIntegrationEndpoint: &ServiceIntegrationEndpoint{EndpointID: "e1"}}
+				case strings.HasSuffix(r.URL.Path, "/integration"):
+					rsp = ServiceIntegrationResponse{ServiceIntegration: &ServiceIntegration{IntegrationType: IntegrationTypeAutoscaler}}
+				default:
+					t.Errorf("unexpected request %s", r.URL.Path)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(rsp); err != nil {
+					t.Error(err)
+				}
+			}))
+			defer ts.Close()
+
+			apiurl = ts.URL
+			c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+			c.Init()
+
+			_, err := c.Services.EnableDiskAutoscaling("test-pr", "my-pg", tt.maxDiskGB)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("EnableDiskAutoscaling() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && capGB != float64(tt.maxDiskGB) {
+				t.Errorf("EnableDiskAutoscaling() got cap_gb = %v, want %v", capGB, tt.maxDiskGB)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ package aiven
 
 import (
 	"fmt"
+	"strings"
 )
 
 type (
@@ -17,6 +18,29 @@ type (
 		EndpointConfig map[string]interface{} `json:"endpoint_config"`
 	}
 
+	// ServiceIntegrationEndpointWithDefaults is a service integration endpoint along with its
+	// effective user config, which has the defaults of the endpoint type applied. UserConfig
+	// of the endpoint only holds the values set by the user.
+	ServiceIntegrationEndpointWithDefaults struct {
+		*ServiceIntegrationEndpoint
+		EffectiveUserConfig map[string]interface{}
+	}
+
+	// EndpointInUseError is returned when an integration endpoint can't be deleted because
+	// service integrations use it. It matches ErrEndpointInUse with errors.Is.
+	EndpointInUseError struct {
+		EndpointID   string
+		Integrations []*ServiceIntegration
+	}
+
+	// ServiceIntegrationEndpointType represents an integration endpoint type available to a project
+	ServiceIntegrationEndpointType struct {
+		EndpointType     string           `json:"endpoint_type"`
+		Title            string           `json:"title"`
+		ServiceTypes     []string         `json:"service_types"`
+		UserConfigSchema UserConfigSchema `json:"user_config_schema"`
+	}
+
 	// ServiceIntegrationEndpointsHandler is the client that interacts
 	// with the Service Integration Endpoints API endpoints on Aiven.
 	ServiceIntegrationEndpointsHandler struct {
@@ -50,6 +74,13 @@ type (
 		APIResponse
 		ServiceIntegrationEndpoints []*ServiceIntegrationEndpoint `json:"service_integration_endpoints"`
 	}
+
+	// ServiceIntegrationEndpointTypesResponse represents the response from Aiven
+	// for listing service integration endpoint types.
+	ServiceIntegrationEndpointTypesResponse struct {
+		APIResponse
+		EndpointTypes []*ServiceIntegrationEndpointType `json:"endpoint_types"`
+	}
 )
 
 // Create the given Service Integration Endpoint on Aiven.
@@ -88,6 +119,34 @@ func (h *ServiceIntegrationEndpointsHandler) Get(project, endpointID string) (*S
 	return nil, err
 }
 
+// GetWithDefaults gets a specific service integration endpoint from Aiven along with its user
+// config with the defaults of the endpoint type applied. Comparing desired config against
+// UserConfig doesn't report values defaulted by Aiven as differences.
+func (h *ServiceIntegrationEndpointsHandler) GetWithDefaults(
+	project, endpointID string,
+) (*ServiceIntegrationEndpointWithDefaults, error) {
+	endpoint, err := h.Get(project, endpointID)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := h.ListTypes(project)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range types {
+		if t.EndpointType == endpoint.EndpointType {
+			return &ServiceIntegrationEndpointWithDefaults{
+				ServiceIntegrationEndpoint: endpoint,
+				EffectiveUserConfig:        t.UserConfigSchema.WithDefaults(endpoint.UserConfig),
+			}, nil
+		}
+	}
+
+	return nil, Error{Message: "Integration endpoint type " + endpoint.EndpointType + " not found", Status: 404}
+}
+
 // Update the given service integration endpoint with the given parameters.
 func (h *ServiceIntegrationEndpointsHandler) Update(
 	project string,
@@ -106,7 +165,8 @@ func (h *ServiceIntegrationEndpointsHandler) Update(
 	return r.ServiceIntegrationEndpoint, errR
 }
 
-// Delete the given service integration endpoint from Aiven.
+// Delete the given service integration endpoint from Aiven. It doesn't check whether service
+// integrations use the endpoint, to keep existing callers working, see DeleteIfUnused.
 func (h *ServiceIntegrationEndpointsHandler) Delete(project, endpointID string) error {
 	path := buildPath("project", project, "integration_endpoint", endpointID)
 	bts, err := h.client.doDeleteRequest(path, nil)
@@ -117,7 +177,48 @@ func (h *ServiceIntegrationEndpointsHandler) Delete(project, endpointID string)
 	return checkAPIResponse(bts, nil)
 }
 
-// List all service integration endpoints for a given project.
+// DeleteIfUnused deletes the service integration endpoint unless service integrations use
+// it, in which case an EndpointInUseError listing them is returned. With force the endpoint
+// is deleted regardless, breaking the integrations using it.
+func (h *ServiceIntegrationEndpointsHandler) DeleteIfUnused(project, endpointID string, force bool) error {
+	if !force {
+		integrations, err := h.client.ServiceIntegrations.ListAll(project)
+		if err != nil {
+			return err
+		}
+
+		var using []*ServiceIntegration
+		for _, i := range integrations {
+			if (i.SourceEndpointID != nil && *i.SourceEndpointID == endpointID) ||
+				(i.DestinationEndpointID != nil && *i.DestinationEndpointID == endpointID) {
+				using = append(using, i)
+			}
+		}
+
+		if len(using) > 0 {
+			return &EndpointInUseError{EndpointID: endpointID, Integrations: using}
+		}
+	}
+
+	return h.Delete(project, endpointID)
+}
+
+func (e *EndpointInUseError) Error() string {
+	ids := make([]string, 0, len(e.Integrations))
+	for _, i := range e.Integrations {
+		ids = append(ids, i.ServiceIntegrationID)
+	}
+
+	return fmt.Sprintf("integration endpoint %s is used by service integrations %s", e.EndpointID, strings.Join(ids, ", "))
+}
+
+// Is makes errors.Is match the error with ErrEndpointInUse
+func (e *EndpointInUseError) Is(target error) bool {
+	return target == ErrEndpointInUse
+}
+
+// List all service integration endpoints for a given project. Endpoints are project
+// scoped, so this returns every endpoint regardless of the services using it.
 func (h *ServiceIntegrationEndpointsHandler) List(project string) ([]*ServiceIntegrationEndpoint, error) {
 	path := buildPath("project", project, "integration_endpoint")
 	bts, err := h.client.doGetRequest(path, nil)
@@ -130,3 +231,102 @@ func (h *ServiceIntegrationEndpointsHandler) List(project string) ([]*ServiceInt
 
 	return r.ServiceIntegrationEndpoints, errR
 }
+
+// ListTypes lists all service integration endpoint types available to the project.
+func (h *ServiceIntegrationEndpointsHandler) ListTypes(project string) ([]*ServiceIntegrationEndpointType, error) {
+	path := buildPath("project", project, "integration_endpoint_types")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceIntegrationEndpointTypesResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.EndpointTypes, errR
+}
+
+// ValidateConfig checks the user config against the schema of the integration endpoint type
+// before creating or updating an endpoint, see UserConfigSchema.Validate.
+func (h *ServiceIntegrationEndpointsHandler) ValidateConfig(
+	project, endpointType string,
+	userConfig map[string]interface{},
+) error {
+	types, err := h.ListTypes(project)
+	if err != nil {
+		return err
+	}
+
+	for _, t := range types {
+		if t.EndpointType == endpointType {
+			return t.UserConfigSchema.Validate(userConfig)
+		}
+	}
+
+	return Error{Message: "Integration endpoint type " + endpointType + " not found", Status: 404}
+}
+
+// UsageCounts returns the number of service integrations using each integration endpoint
+// of the project, keyed by endpoint ID. Unused endpoints have a count of zero.
+func (h *ServiceIntegrationEndpointsHandler) UsageCounts(project string) (map[string]int, error) {
+	endpoints, err := h.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	integrations, err := h.client.ServiceIntegrations.ListAll(project)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[string]int, len(endpoints))
+	for _, e := range endpoints {
+		counts[e.EndpointID] = 0
+	}
+
+	for _, i := range integrations {
+		if i.SourceEndpointID != nil {
+			counts[*i.SourceEndpointID]++
+		}
+		if i.DestinationEndpointID != nil {
+			counts[*i.DestinationEndpointID]++
+		}
+	}
+
+	return counts, nil
+}
+
+// CompatibleEndpoints lists the integration endpoints of the project the service can be
+// integrated with, i.e. the endpoints whose type supports the type of the service.
+func (h *ServicesHandler) CompatibleEndpoints(project, service string) ([]*ServiceIntegrationEndpoint, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	types, err := h.client.ServiceIntegrationEndpoints.ListTypes(project)
+	if err != nil {
+		return nil, err
+	}
+
+	compatible := make(map[string]bool)
+	for _, t := range types {
+		if containsString(t.ServiceTypes, s.Type) {
+			compatible[t.EndpointType] = true
+		}
+	}
+
+	endpoints, err := h.client.ServiceIntegrationEndpoints.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	var r []*ServiceIntegrationEndpoint
+	for _, e := range endpoints {
+		if compatible[e.EndpointType] {
+			r = append(r, e)
+		}
+	}
+
+	return r, nil
+}
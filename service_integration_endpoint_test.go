@@ -0,0 +1,109 @@
+package aiven
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestServiceIntegrationEndpointsHandler_DeleteIfUnused(t *testing.T) {
+	var deleted []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/project/test-pr/service" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"services": [
+				{"service_name": "kafka", "service_integrations": [
+					{"service_integration_id": "i1", "source_service": "kafka", "dest_endpoint_id": "used"}]},
+				{"service_name": "pg", "service_integrations": [
+					{"service_integration_id": "i2", "source_endpoint_id": "used", "dest_service": "pg"}]}]}`))
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			_, _ = w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	err := c.ServiceIntegrationEndpoints.DeleteIfUnused("test-pr", "used", false)
+	if !errors.Is(err, ErrEndpointInUse) {
+		t.Fatalf("DeleteIfUnused() error = %v, want ErrEndpointInUse", err)
+	}
+
+	var inUse *EndpointInUseError
+	if !errors.As(err, &inUse) {
+		t.Fatalf("DeleteIfUnused() error = %T, want *EndpointInUseError", err)
+	}
+	if len(inUse.Integrations) != 2 || inUse.Integrations[0].ServiceIntegrationID != "i1" ||
+		inUse.Integrations[1].ServiceIntegrationID != "i2" {
+		t.Errorf("DeleteIfUnused() integrations = %v, want i1 and i2", inUse.Integrations)
+	}
+	if len(deleted) != 0 {
+		t.Fatalf("DeleteIfUnused() deleted %v, want nothing deleted", deleted)
+	}
+
+	if err := c.ServiceIntegrationEndpoints.DeleteIfUnused("test-pr", "unused", false); err != nil {
+		t.Fatalf("DeleteIfUnused() error = %v", err)
+	}
+
+	if err := c.ServiceIntegrationEndpoints.DeleteIfUnused("test-pr", "used", true); err != nil {
+		t.Fatalf("DeleteIfUnused() with force error = %v", err)
+	}
+
+	want := []string{"/project/test-pr/integration_endpoint/unused", "/project/test-pr/integration_endpoint/used"}
+	if len(deleted) != len(want) || deleted[0] != want[0] || deleted[1] != want[1] {
+		t.Errorf("DeleteIfUnused() deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestServicesHandler_CompatibleEndpoints(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/project/test-pr/service/my-pg":
+			_, _ = w.Write([]byte(`{"service": {"service_name": "my-pg", "service_type": "pg"}}`))
+		case "/project/test-pr/integration_endpoint_types":
+			_, _ = w.Write([]byte(`{"endpoint_types": [
+				{"endpoint_type": "datadog", "service_types": ["kafka", "pg"]},
+				{"endpoint_type": "external_kafka", "service_types": ["kafka"]}]}`))
+		case "/project/test-pr/integration_endpoint":
+			_, _ = w.Write([]byte(`{"service_integration_endpoints": [
+				{"endpoint_id": "e1", "endpoint_type": "datadog"},
+				{"endpoint_id": "e2", "endpoint_type": "external_kafka"},
+				{"endpoint_id": "e3", "endpoint_type": "datadog"}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Service not found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	got, err := c.Services.CompatibleEndpoints("test-pr", "my-pg")
+	if err != nil {
+		t.Fatalf("CompatibleEndpoints() error = %v", err)
+	}
+
+	var ids []string
+	for _, e := range got {
+		ids = append(ids, e.EndpointID)
+	}
+	if want := []string{"e1", "e3"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("CompatibleEndpoints() got = %v, want %v", ids, want)
+	}
+
+	if _, err := c.Services.CompatibleEndpoints("test-pr", "missing"); !IsNotFound(err) {
+		t.Errorf("CompatibleEndpoints() error = %v, want not found", err)
+	}
+}
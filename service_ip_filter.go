@@ -0,0 +1,105 @@
+package aiven
+
+import (
+	"fmt"
+	"net"
+)
+
+// SetIPFilter replaces the IP filter of the service with the given networks (IPs or CIDRs)
+// allowed to connect to it. The rest of the user config is left untouched.
+func (h *ServicesHandler) SetIPFilter(project, service string, networks []string) (*Service, error) {
+	if err := validateIPFilter(networks); err != nil {
+		return nil, err
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := make([]interface{}, 0, len(networks))
+	for _, n := range networks {
+		filter = append(filter, n)
+	}
+
+	return h.updateUserConfig(project, s, map[string]interface{}{"ip_filter": filter})
+}
+
+// AddIPFilter adds the given networks (IPs or CIDRs) to the IP filter of the service,
+// networks already in the filter are skipped.
+func (h *ServicesHandler) AddIPFilter(project, service string, networks []string) (*Service, error) {
+	if err := validateIPFilter(networks); err != nil {
+		return nil, err
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, _ := s.UserConfig["ip_filter"].([]interface{})
+	for _, n := range networks {
+		if !ipFilterContains(filter, n) {
+			filter = append(filter, n)
+		}
+	}
+
+	return h.updateUserConfig(project, s, map[string]interface{}{"ip_filter": filter})
+}
+
+// RemoveIPFilter removes the given networks from the IP filter of the service.
+func (h *ServicesHandler) RemoveIPFilter(project, service string, networks []string) (*Service, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	current, _ := s.UserConfig["ip_filter"].([]interface{})
+	filter := make([]interface{}, 0, len(current))
+	for _, f := range current {
+		if !containsString(networks, ipFilterNetwork(f)) {
+			filter = append(filter, f)
+		}
+	}
+
+	return h.updateUserConfig(project, s, map[string]interface{}{"ip_filter": filter})
+}
+
+// validateIPFilter checks that all networks are IP addresses or CIDRs
+func validateIPFilter(networks []string) error {
+	for _, n := range networks {
+		if net.ParseIP(n) != nil {
+			continue
+		}
+
+		if _, _, err := net.ParseCIDR(n); err != nil {
+			return fmt.Errorf("ip filter entry %q is not an IP address or a CIDR", n)
+		}
+	}
+
+	return nil
+}
+
+// ipFilterNetwork returns the network of an IP filter entry, which is either a plain
+// string or an object with a network and a description
+func ipFilterNetwork(f interface{}) string {
+	switch v := f.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		n, _ := v["network"].(string)
+		return n
+	}
+
+	return ""
+}
+
+func ipFilterContains(filter []interface{}, network string) bool {
+	for _, f := range filter {
+		if ipFilterNetwork(f) == network {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package aiven
+
+import "testing"
+
+func Test_validateIPFilter(t *testing.T) {
+	tests := []struct {
+		name     string
+		networks []string
+		wantErr  bool
+	}{
+		{"empty", nil, false},
+		{"valid", []string{"10.0.0.0/8", "192.168.1.1", "::/0", "2001:db8::1"}, false},
+		{"invalid mask", []string{"10.0.0.0/33"}, true},
+		{"typo", []string{"10.0.0/24"}, true},
+		{"hostname", []string{"example.com"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateIPFilter(tt.networks); (err != nil) != tt.wantErr {
+				t.Errorf("validateIPFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_ipFilterContains(t *testing.T) {
+	filter := []interface{}{
+		"10.0.0.0/8",
+		map[string]interface{}{"network": "192.168.0.0/16", "description": "office"},
+	}
+
+	for network, want := range map[string]bool{
+		"10.0.0.0/8":     true,
+		"192.168.0.0/16": true,
+		"0.0.0.0/0":      false,
+	} {
+		if got := ipFilterContains(filter, network); got != want {
+			t.Errorf("ipFilterContains(%s) = %v, want %v", network, got, want)
+		}
+	}
+}
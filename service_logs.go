@@ -0,0 +1,51 @@
+package aiven
+
+const (
+	// LogsSortOrderAsc returns the log entries after the offset, oldest first
+	LogsSortOrderAsc = "asc"
+	// LogsSortOrderDesc returns the log entries before the offset, newest first
+	LogsSortOrderDesc = "desc"
+)
+
+type (
+	// ServiceLogEntry is a log entry of a service node
+	ServiceLogEntry struct {
+		Time string `json:"time"`
+		Msg  string `json:"msg"`
+		Unit string `json:"unit,omitempty"`
+	}
+
+	// GetLogsRequest are the parameters to fetch service logs. Offset is the cursor returned
+	// by the previous call, empty to start from the newest (desc) or oldest (asc) entry.
+	GetLogsRequest struct {
+		Limit     int    `json:"limit,omitempty"`
+		Offset    string `json:"offset,omitempty"`
+		SortOrder string `json:"sort_order,omitempty"`
+	}
+
+	// ServiceLogsResponse represents the response from Aiven for the logs of a service.
+	// Offset is the cursor to pass in the next GetLogsRequest to continue after these logs.
+	ServiceLogsResponse struct {
+		APIResponse
+		FirstLogOffset string             `json:"first_log_offset"`
+		Logs           []*ServiceLogEntry `json:"logs"`
+		Offset         string             `json:"offset"`
+	}
+)
+
+// GetLogs fetches log entries of the service. To follow the logs pass the returned Offset in
+// the next request with LogsSortOrderAsc, no new entries are returned until there are some.
+func (h *ServicesHandler) GetLogs(project, service string, req GetLogsRequest) (*ServiceLogsResponse, error) {
+	path := buildPath("project", project, "service", service, "logs")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceLogsResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,55 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestServicesHandler_GetLogs(t *testing.T) {
+	var offsets []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/project/test-pr/service/my-pg/logs" || r.Method != http.MethodPost {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var req GetLogsRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		offsets = append(offsets, req.Offset)
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(ServiceLogsResponse{
+			FirstLogOffset: "1000",
+			Logs:           []*ServiceLogEntry{{Time: "2021-06-16T12:00:00Z", Msg: "checkpoint complete", Unit: "postgresql-13.service"}},
+			Offset:         "1001",
+		})
+		if err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	req := GetLogsRequest{Limit: 100, SortOrder: LogsSortOrderAsc}
+	for i := 0; i < 2; i++ {
+		r, err := c.Services.GetLogs("test-pr", "my-pg", req)
+		if err != nil {
+			t.Fatalf("GetLogs() error = %v", err)
+		}
+
+		if len(r.Logs) != 1 || r.Logs[0].Unit != "postgresql-13.service" {
+			t.Errorf("GetLogs() got logs %v", r.Logs)
+		}
+		req.Offset = r.Offset
+	}
+
+	if len(offsets) != 2 || offsets[0] != "" || offsets[1] != "1001" {
+		t.Errorf("GetLogs() sent offsets %q, want \"\" and \"1001\"", offsets)
+	}
+}
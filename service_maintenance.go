@@ -0,0 +1,114 @@
+package aiven
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maintenanceWindowNever is the day of week of services without a maintenance window
+const maintenanceWindowNever = "never"
+
+// schedule returns the window without its pending updates, which can't be sent back to Aiven
+func (w MaintenanceWindow) schedule() *MaintenanceWindow {
+	return &MaintenanceWindow{DayOfWeek: w.DayOfWeek, TimeOfDay: w.TimeOfDay}
+}
+
+// NextMaintenanceTime predicts when the pending maintenance updates of the service are
+// applied: at the first maintenance window after the updates become available, or at their
+// deadline if it comes first. It returns nil when no updates are pending.
+func (h *ServicesHandler) NextMaintenanceTime(project, service string) (*time.Time, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	return nextMaintenanceTime(s.MaintenanceWindow, time.Now().UTC())
+}
+
+// nextMaintenanceTime predicts when the pending updates of the window are applied after now
+func nextMaintenanceTime(w MaintenanceWindow, now time.Time) (*time.Time, error) {
+	if len(w.Updates) == 0 {
+		return nil, nil
+	}
+
+	var startAt, startAfter, deadline *time.Time
+	for _, u := range w.Updates {
+		for _, f := range []struct {
+			name  string
+			value string
+			dst   **time.Time
+		}{
+			{"start_at", u.StartAt, &startAt},
+			{"start_after", u.StartAfter, &startAfter},
+			{"deadline", u.Deadline, &deadline},
+		} {
+			if f.value == "" {
+				continue
+			}
+
+			t, err := time.Parse(time.RFC3339, f.value)
+			if err != nil {
+				return nil, fmt.Errorf("cannot parse maintenance update %s %q: %w", f.name, f.value, err)
+			}
+
+			if *f.dst == nil || t.Before(**f.dst) {
+				*f.dst = &t
+			}
+		}
+	}
+
+	// An update with a start time is already scheduled
+	if startAt != nil {
+		return startAt, nil
+	}
+
+	earliest := now
+	if startAfter != nil && startAfter.After(earliest) {
+		earliest = *startAfter
+	}
+
+	next, err := nextMaintenanceWindow(w, earliest)
+	if err != nil {
+		return nil, err
+	}
+
+	if deadline != nil && (next == nil || deadline.Before(*next)) {
+		return deadline, nil
+	}
+
+	return next, nil
+}
+
+// nextMaintenanceWindow returns the start of the first maintenance window at or after t,
+// nil when the service has no maintenance window
+func nextMaintenanceWindow(w MaintenanceWindow, t time.Time) (*time.Time, error) {
+	dow := strings.ToLower(w.DayOfWeek)
+	if dow == "" || dow == maintenanceWindowNever {
+		return nil, nil
+	}
+
+	weekday := -1
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.ToLower(d.String()) == dow {
+			weekday = int(d)
+		}
+	}
+	if weekday < 0 {
+		return nil, fmt.Errorf("invalid maintenance window day of week %q", w.DayOfWeek)
+	}
+
+	tod, err := time.Parse("15:04:05", w.TimeOfDay)
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance window time %q: %w", w.TimeOfDay, err)
+	}
+
+	t = t.UTC()
+	days := (weekday - int(t.Weekday()) + 7) % 7
+	next := time.Date(t.Year(), t.Month(), t.Day()+days, tod.Hour(), tod.Minute(), tod.Second(), 0, time.UTC)
+	if next.Before(t) {
+		next = next.AddDate(0, 0, 7)
+	}
+
+	return &next, nil
+}
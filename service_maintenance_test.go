@@ -0,0 +1,102 @@
+package aiven
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_nextMaintenanceTime(t *testing.T) {
+	// Wednesday
+	now := time.Date(2021, 6, 16, 12, 0, 0, 0, time.UTC)
+	at := func(s string) *time.Time {
+		t, _ := time.Parse(time.RFC3339, s)
+		return &t
+	}
+
+	tests := []struct {
+		name    string
+		window  MaintenanceWindow
+		want    *time.Time
+		wantErr bool
+	}{
+		{
+			"no-updates",
+			MaintenanceWindow{DayOfWeek: "monday", TimeOfDay: "03:00:00"},
+			nil,
+			false,
+		},
+		{
+			"next-window",
+			MaintenanceWindow{DayOfWeek: "monday", TimeOfDay: "03:00:00", Updates: []*MaintenanceUpdate{{}}},
+			at("2021-06-21T03:00:00Z"),
+			false,
+		},
+		{
+			"window-later-today",
+			MaintenanceWindow{DayOfWeek: "wednesday", TimeOfDay: "18:30:00", Updates: []*MaintenanceUpdate{{}}},
+			at("2021-06-16T18:30:00Z"),
+			false,
+		},
+		{
+			"window-passed-today",
+			MaintenanceWindow{DayOfWeek: "wednesday", TimeOfDay: "06:00:00", Updates: []*MaintenanceUpdate{{}}},
+			at("2021-06-23T06:00:00Z"),
+			false,
+		},
+		{
+			"start-after",
+			MaintenanceWindow{
+				DayOfWeek: "monday",
+				TimeOfDay: "03:00:00",
+				Updates:   []*MaintenanceUpdate{{StartAfter: "2021-06-22T00:00:00Z"}},
+			},
+			at("2021-06-28T03:00:00Z"),
+			false,
+		},
+		{
+			"deadline-before-window",
+			MaintenanceWindow{
+				DayOfWeek: "monday",
+				TimeOfDay: "03:00:00",
+				Updates:   []*MaintenanceUpdate{{Deadline: "2021-06-18T00:00:00Z"}},
+			},
+			at("2021-06-18T00:00:00Z"),
+			false,
+		},
+		{
+			"scheduled",
+			MaintenanceWindow{
+				DayOfWeek: "monday",
+				TimeOfDay: "03:00:00",
+				Updates:   []*MaintenanceUpdate{{StartAt: "2021-06-17T10:00:00Z"}},
+			},
+			at("2021-06-17T10:00:00Z"),
+			false,
+		},
+		{
+			"never",
+			MaintenanceWindow{DayOfWeek: "never", Updates: []*MaintenanceUpdate{{Deadline: "2021-07-01T00:00:00Z"}}},
+			at("2021-07-01T00:00:00Z"),
+			false,
+		},
+		{
+			"invalid-day",
+			MaintenanceWindow{DayOfWeek: "someday", TimeOfDay: "03:00:00", Updates: []*MaintenanceUpdate{{}}},
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := nextMaintenanceTime(tt.window, now)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("nextMaintenanceTime() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if (got == nil) != (tt.want == nil) || (got != nil && !got.Equal(*tt.want)) {
+				t.Errorf("nextMaintenanceTime() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package aiven
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MetricsPeriodHour requests the service metrics of the last hour
+	MetricsPeriodHour = "hour"
+	// MetricsPeriodDay requests the service metrics of the last day
+	MetricsPeriodDay = "day"
+	// MetricsPeriodWeek requests the service metrics of the last week
+	MetricsPeriodWeek = "week"
+	// MetricsPeriodMonth requests the service metrics of the last month
+	MetricsPeriodMonth = "month"
+	// MetricsPeriodYear requests the service metrics of the last year
+	MetricsPeriodYear = "year"
+
+	// metricDiskUsage is the disk usage percentage of the service nodes
+	metricDiskUsage = "disk_usage"
+)
+
+type (
+	// ServiceMetric is a time series of a service metric. The first column of the data is
+	// the time, the others hold the values of each service node.
+	ServiceMetric struct {
+		Data ServiceMetricData `json:"data"`
+	}
+
+	// ServiceMetricData holds the columns and rows of a service metric
+	ServiceMetricData struct {
+		Cols []ServiceMetricColumn `json:"cols"`
+		Rows [][]interface{}       `json:"rows"`
+	}
+
+	// ServiceMetricColumn describes a column of a service metric
+	ServiceMetricColumn struct {
+		Label string `json:"label"`
+		Type  string `json:"type"`
+	}
+
+	// ServiceMetricSeries is the time series of a metric for a single service node
+	ServiceMetricSeries struct {
+		Label  string
+		Points []ServiceMetricPoint
+	}
+
+	// ServiceMetricPoint is a value of a metric at the given time
+	ServiceMetricPoint struct {
+		Time  string
+		Value *float64
+	}
+
+	// ServiceMetricsRequest are the parameters to fetch the metrics of a service
+	ServiceMetricsRequest struct {
+		Period string `json:"period"`
+	}
+
+	// ServiceMetricsResponse represents the response from Aiven for the metrics of a service
+	ServiceMetricsResponse struct {
+		APIResponse
+		Metrics map[string]ServiceMetric `json:"metrics"`
+	}
+
+	// DiskUsage is the disk usage of a service, on the most used node of the service
+	DiskUsage struct {
+		UsedBytes  int64
+		TotalBytes int64
+		Percent    float64
+	}
+)
+
+// metricsPeriods are the periods service metrics can be fetched for
+var metricsPeriods = []string{MetricsPeriodHour, MetricsPeriodDay, MetricsPeriodWeek, MetricsPeriodMonth, MetricsPeriodYear}
+
+// GetMetrics fetches the metrics of the service over the period, e.g. MetricsPeriodHour, keyed
+// by metric name such as `cpu_usage` or `disk_usage`. A service which hasn't collected
+// metrics yet has none, which is not an error.
+func (h *ServicesHandler) GetMetrics(project, service, period string) (map[string]ServiceMetric, error) {
+	if !containsString(metricsPeriods, period) {
+		return nil, fmt.Errorf("invalid metrics period %q, must be one of %s", period, strings.Join(metricsPeriods, ", "))
+	}
+
+	path := buildPath("project", project, "service", service, "metrics")
+	bts, err := h.client.doPostRequest(path, ServiceMetricsRequest{Period: period})
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceMetricsResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	if r.Metrics == nil {
+		r.Metrics = map[string]ServiceMetric{}
+	}
+
+	return r.Metrics, nil
+}
+
+// Series returns the values of the metric as a series per service node, labeled by the
+// column labels. Points without a value have a nil Value.
+func (m ServiceMetric) Series() []ServiceMetricSeries {
+	if len(m.Data.Cols) < 2 {
+		return []ServiceMetricSeries{}
+	}
+
+	series := make([]ServiceMetricSeries, len(m.Data.Cols)-1)
+	for i, c := range m.Data.Cols[1:] {
+		series[i].Label = c.Label
+	}
+
+	for _, row := range m.Data.Rows {
+		if len(row) == 0 {
+			continue
+		}
+
+		t, _ := row[0].(string)
+		for i := range series {
+			p := ServiceMetricPoint{Time: t}
+			if i+1 < len(row) {
+				if v, err := ToFloat64(row[i+1]); err == nil {
+					p.Value = &v
+				}
+			}
+			series[i].Points = append(series[i].Points, p)
+		}
+	}
+
+	return series
+}
+
+// DiskUsage returns the current disk usage of the service, based on the latest disk usage
+// metric of its most used node and the disk space of the service.
+func (h *ServicesHandler) DiskUsage(project, service string) (*DiskUsage, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	diskSpaceMB := s.DiskSpaceMB
+	if diskSpaceMB == 0 {
+		plan, err := h.client.ServiceTypes.GetPlan(project, s.Type, s.Plan)
+		if err != nil {
+			return nil, err
+		}
+		diskSpaceMB = plan.DiskSpaceMB
+	}
+
+	metrics, err := h.GetMetrics(project, service, MetricsPeriodHour)
+	if err != nil {
+		return nil, err
+	}
+
+	percent, ok := latestMetricMax(metrics[metricDiskUsage])
+	if !ok {
+		return nil, Error{Message: fmt.Sprintf("Disk usage of service %s not available", service), Status: 404}
+	}
+
+	total := int64(diskSpaceMB) * 1024 * 1024
+	return &DiskUsage{
+		UsedBytes:  int64(float64(total) * percent / 100),
+		TotalBytes: total,
+		Percent:    percent,
+	}, nil
+}
+
+// latestMetricMax returns the largest node value of the latest row of the metric which has
+// values. It returns false when the metric has no values.
+func latestMetricMax(m ServiceMetric) (float64, bool) {
+	for i := len(m.Data.Rows) - 1; i >= 0; i-- {
+		var max float64
+		found := false
+
+		// The first column is the time
+		for _, v := range m.Data.Rows[i][1:] {
+			f, err := ToFloat64(v)
+			if err != nil {
+				continue
+			}
+
+			if !found || f > max {
+				max = f
+			}
+			found = true
+		}
+
+		if found {
+			return max, true
+		}
+	}
+
+	return 0, false
+}
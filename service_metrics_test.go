@@ -0,0 +1,74 @@
+package aiven
+
+import "testing"
+
+func Test_latestMetricMax(t *testing.T) {
+	tests := []struct {
+		name   string
+		rows   string
+		want   float64
+		wantOk bool
+	}{
+		{"empty", `[]`, 0, false},
+		{
+			"latest",
+			`[["2021-06-16T12:00:00Z", 40.0, 42], ["2021-06-16T12:01:00Z", 41.0, 43.5]]`,
+			43.5,
+			true,
+		},
+		{
+			"latest-without-values",
+			`[["2021-06-16T12:00:00Z", 40.0, 42.0], ["2021-06-16T12:01:00Z", null, null]]`,
+			42.0,
+			true,
+		},
+		{"no-values", `[["2021-06-16T12:00:00Z", null]]`, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var r ServiceMetricsResponse
+			bts := []byte(`{"metrics": {"disk_usage": {"data": {"rows": ` + tt.rows + `}}}}`)
+			if err := checkAPIResponse(bts, &r); err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := latestMetricMax(r.Metrics[metricDiskUsage])
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("latestMetricMax() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestServiceMetric_Series(t *testing.T) {
+	bts := []byte(`{"metrics": {"cpu_usage": {"data": {
+		"cols": [{"label": "time", "type": "date"}, {"label": "pg-1", "type": "number"}, {"label": "pg-2", "type": "number"}],
+		"rows": [["2021-06-16T12:00:00Z", 10.5, null], ["2021-06-16T12:01:00Z", 11, 12.0]]
+	}}}}`)
+
+	var r ServiceMetricsResponse
+	if err := checkAPIResponse(bts, &r); err != nil {
+		t.Fatal(err)
+	}
+
+	got := r.Metrics["cpu_usage"].Series()
+	if len(got) != 2 || got[0].Label != "pg-1" || got[1].Label != "pg-2" {
+		t.Fatalf("Series() got = %+v", got)
+	}
+
+	if len(got[0].Points) != 2 || got[0].Points[0].Value == nil || *got[0].Points[0].Value != 10.5 {
+		t.Errorf("Series() got points = %+v", got[0].Points)
+	}
+
+	if len(got[1].Points) != 2 || got[1].Points[0].Value != nil || got[1].Points[1].Value == nil || *got[1].Points[1].Value != 12.0 {
+		t.Errorf("Series() got points = %+v", got[1].Points)
+	}
+
+	if got[1].Points[1].Time != "2021-06-16T12:01:00Z" {
+		t.Errorf("Series() got time = %v", got[1].Points[1].Time)
+	}
+
+	if got := (ServiceMetric{}).Series(); len(got) != 0 {
+		t.Errorf("Series() of an empty metric got = %+v", got)
+	}
+}
@@ -0,0 +1,79 @@
+package aiven
+
+import (
+	"fmt"
+	"time"
+)
+
+// MigrationMethodReplication is the method of a migration which keeps replicating from the
+// source until the service is promoted
+const MigrationMethodReplication = "replication"
+
+type (
+	// ServiceMigration is the status of the migration of data from an external database into a service
+	ServiceMigration struct {
+		Error                  *string                   `json:"error"`
+		MasterLastIOSecondsAgo *int                      `json:"master_last_io_seconds_ago"`
+		MasterLinkStatus       *string                   `json:"master_link_status"`
+		Method                 string                    `json:"method"`
+		Status                 string                    `json:"status"`
+		Details                []*ServiceMigrationDetail `json:"migration_detail,omitempty"`
+	}
+
+	// ServiceMigrationDetail is the status of the migration of a single database
+	ServiceMigrationDetail struct {
+		DatabaseName string  `json:"dbname"`
+		Error        *string `json:"error"`
+		Method       string  `json:"method"`
+		Status       string  `json:"status"`
+	}
+
+	// ServiceMigrationResponse represents the response from Aiven for the migration status of a service
+	ServiceMigrationResponse struct {
+		APIResponse
+		Migration ServiceMigration `json:"migration"`
+	}
+)
+
+// Migration returns the status of the migration of data from an external database into the
+// service, configured by the `migration` user config.
+func (h *ServicesHandler) Migration(project, service string) (*ServiceMigration, error) {
+	path := buildPath("project", project, "service", service, "migration")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceMigrationResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	return &r.Migration, nil
+}
+
+// MigrationTimeSinceLastIO returns how long ago the service last received data from the source
+// of a migration in replication mode, from master_last_io_seconds_ago. The migration status has
+// no replication lag field and this is not the lag: it keeps growing once the writes to the
+// source stop, even when the service is fully caught up. Check MasterLinkStatus of Migration
+// to tell whether the service is still connected to the source.
+func (h *ServicesHandler) MigrationTimeSinceLastIO(project, service string) (time.Duration, error) {
+	m, err := h.Migration(project, service)
+	if err != nil {
+		return 0, err
+	}
+
+	if m.Method != MigrationMethodReplication {
+		return 0, fmt.Errorf("service %s migration uses method %q, not replication", service, m.Method)
+	}
+
+	if m.Error != nil && *m.Error != "" {
+		return 0, fmt.Errorf("service %s migration failed: %s", service, *m.Error)
+	}
+
+	if m.MasterLastIOSecondsAgo == nil {
+		return 0, fmt.Errorf("service %s migration has not replicated from the source yet", service)
+	}
+
+	return time.Duration(*m.MasterLastIOSecondsAgo) * time.Second, nil
+}
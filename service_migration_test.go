@@ -0,0 +1,57 @@
+package aiven
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestServicesHandler_MigrationTimeSinceLastIO(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/project/test-pr/service/replicating/migration":
+			_, _ = w.Write([]byte(`{"migration": {"method": "replication", "status": "syncing",
+				"master_link_status": "up", "master_last_io_seconds_ago": 3}}`))
+		case "/project/test-pr/service/starting/migration":
+			_, _ = w.Write([]byte(`{"migration": {"method": "replication", "status": "running"}}`))
+		case "/project/test-pr/service/dump/migration":
+			_, _ = w.Write([]byte(`{"migration": {"method": "dump", "status": "done"}}`))
+		case "/project/test-pr/service/failed/migration":
+			_, _ = w.Write([]byte(`{"migration": {"method": "replication", "status": "failed",
+				"error": "authentication failed", "master_last_io_seconds_ago": 3}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Service not found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	tests := []struct {
+		service string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"replicating", 3 * time.Second, false},
+		{"starting", 0, true},
+		{"dump", 0, true},
+		{"failed", 0, true},
+		{"missing", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.service, func(t *testing.T) {
+			got, err := c.Services.MigrationTimeSinceLastIO("test-pr", tt.service)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("MigrationTimeSinceLastIO() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("MigrationTimeSinceLastIO() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
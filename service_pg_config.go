@@ -0,0 +1,119 @@
+package aiven
+
+import "fmt"
+
+type (
+	// PGUserConfig holds the tuning settings of a PostgreSQL service user config. Settings left
+	// nil are not changed.
+	PGUserConfig struct {
+		// SharedBuffersPercentage is the share of memory used for shared buffers, 20-60
+		SharedBuffersPercentage *float64 `json:"shared_buffers_percentage,omitempty"`
+		// WorkMem is the memory used by internal sort operations and hash tables in MB, 1-1024
+		WorkMem *int      `json:"work_mem,omitempty"`
+		PG      *PGConfig `json:"pg,omitempty"`
+	}
+
+	// PGConfig holds the `pg` settings of a PostgreSQL service user config, which are passed to
+	// the PostgreSQL server configuration
+	PGConfig struct {
+		// MaxParallelWorkers is the maximum number of parallel workers, 0-96
+		MaxParallelWorkers *int `json:"max_parallel_workers,omitempty"`
+		// MaxParallelWorkersPerGather is the maximum number of workers of a Gather node, 0-96
+		MaxParallelWorkersPerGather *int `json:"max_parallel_workers_per_gather,omitempty"`
+		// MaxWorkerProcesses is the maximum number of background processes, 8-96
+		MaxWorkerProcesses *int `json:"max_worker_processes,omitempty"`
+		// MaxLocksPerTransaction is the average number of locks of a transaction, 64-6400
+		MaxLocksPerTransaction *int `json:"max_locks_per_transaction,omitempty"`
+		// AutovacuumMaxWorkers is the maximum number of autovacuum processes, 1-20
+		AutovacuumMaxWorkers *int `json:"autovacuum_max_workers,omitempty"`
+		// AutovacuumNaptime is the delay between autovacuum runs in seconds, 1-86400
+		AutovacuumNaptime *int `json:"autovacuum_naptime,omitempty"`
+		// DeadlockTimeout is the wait on a lock before checking for a deadlock in ms, 500-1800000
+		DeadlockTimeout *int `json:"deadlock_timeout,omitempty"`
+		// IdleInTransactionSessionTimeout terminates idle transactions after the given seconds, 0-604800
+		IdleInTransactionSessionTimeout *int `json:"idle_in_transaction_session_timeout,omitempty"`
+		// LogMinDurationStatement logs statements running longer than the given ms, -1-86400000
+		LogMinDurationStatement *int `json:"log_min_duration_statement,omitempty"`
+		// TempFileLimit is the temporary file space of a process in kB, -1-2147483647
+		TempFileLimit *int `json:"temp_file_limit,omitempty"`
+		// JIT enables just-in-time compilation
+		JIT *bool `json:"jit,omitempty"`
+	}
+)
+
+// Validate checks that the settings are within the ranges accepted by Aiven
+func (c PGUserConfig) Validate() error {
+	if p := c.SharedBuffersPercentage; p != nil && (*p < 20 || *p > 60) {
+		return fmt.Errorf("shared_buffers_percentage must be between 20 and 60, got %v", *p)
+	}
+
+	if err := validatePGSetting("work_mem", c.WorkMem, 1, 1024); err != nil {
+		return err
+	}
+
+	if c.PG == nil {
+		return nil
+	}
+
+	for _, s := range []struct {
+		name     string
+		value    *int
+		min, max int
+	}{
+		{"max_parallel_workers", c.PG.MaxParallelWorkers, 0, 96},
+		{"max_parallel_workers_per_gather", c.PG.MaxParallelWorkersPerGather, 0, 96},
+		{"max_worker_processes", c.PG.MaxWorkerProcesses, 8, 96},
+		{"max_locks_per_transaction", c.PG.MaxLocksPerTransaction, 64, 6400},
+		{"autovacuum_max_workers", c.PG.AutovacuumMaxWorkers, 1, 20},
+		{"autovacuum_naptime", c.PG.AutovacuumNaptime, 1, 86400},
+		{"deadlock_timeout", c.PG.DeadlockTimeout, 500, 1800000},
+		{"idle_in_transaction_session_timeout", c.PG.IdleInTransactionSessionTimeout, 0, 604800},
+		{"log_min_duration_statement", c.PG.LogMinDurationStatement, -1, 86400000},
+		{"temp_file_limit", c.PG.TempFileLimit, -1, 2147483647},
+	} {
+		if err := validatePGSetting("pg."+s.name, s.value, s.min, s.max); err != nil {
+			return err
+		}
+	}
+
+	if w, g := c.PG.MaxParallelWorkers, c.PG.MaxParallelWorkersPerGather; w != nil && g != nil && *g > *w {
+		return fmt.Errorf("pg.max_parallel_workers_per_gather %d exceeds pg.max_parallel_workers %d", *g, *w)
+	}
+
+	return nil
+}
+
+func validatePGSetting(name string, v *int, min, max int) error {
+	if v != nil && (*v < min || *v > max) {
+		return fmt.Errorf("%s must be between %d and %d, got %d", name, min, max, *v)
+	}
+	return nil
+}
+
+// SetPGConfig validates and applies the tuning settings to the PostgreSQL service. Settings
+// left nil and the rest of the user config are left untouched.
+func (h *ServicesHandler) SetPGConfig(project, service string, c PGUserConfig) (*Service, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Type != "pg" {
+		return nil, fmt.Errorf("service %s of type %s is not a PostgreSQL service", service, s.Type)
+	}
+
+	userConfig, err := toUserConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if pg, ok := userConfig["pg"].(map[string]interface{}); ok {
+		userConfig["pg"] = mergeUserConfigObject(s.UserConfig, "pg", pg)
+	}
+
+	return h.updateUserConfig(project, s, userConfig)
+}
@@ -0,0 +1,90 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestPGUserConfig_Validate(t *testing.T) {
+	i := func(v int) *int { return &v }
+	f := func(v float64) *float64 { return &v }
+
+	tests := []struct {
+		name    string
+		config  PGUserConfig
+		wantErr bool
+	}{
+		{"empty", PGUserConfig{}, false},
+		{
+			"normal",
+			PGUserConfig{
+				SharedBuffersPercentage: f(40),
+				WorkMem:                 i(64),
+				PG:                      &PGConfig{MaxParallelWorkers: i(8), MaxParallelWorkersPerGather: i(4)},
+			},
+			false,
+		},
+		{"shared-buffers-over", PGUserConfig{SharedBuffersPercentage: f(120)}, true},
+		{"work-mem-zero", PGUserConfig{WorkMem: i(0)}, true},
+		{"worker-processes-under", PGUserConfig{PG: &PGConfig{MaxWorkerProcesses: i(4)}}, true},
+		{"log-disabled", PGUserConfig{PG: &PGConfig{LogMinDurationStatement: i(-1)}}, false},
+		{
+			"per-gather-over-workers",
+			PGUserConfig{PG: &PGConfig{MaxParallelWorkers: i(2), MaxParallelWorkersPerGather: i(4)}},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.config.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServicesHandler_SetPGConfig(t *testing.T) {
+	i := func(v int) *int { return &v }
+
+	var got map[string]interface{}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s := &Service{Name: "my-pg", Type: "pg", UserConfig: map[string]interface{}{
+			"pg_version": "15",
+			"pg":         map[string]interface{}{"log_min_duration_statement": 1000, "max_parallel_workers": 4},
+		}}
+
+		if r.Method == "PUT" {
+			var req UpdateServiceRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				t.Error(err)
+			}
+			got = req.UserConfig
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(ServiceResponse{Service: s}); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	_, err := c.Services.SetPGConfig("test-pr", "my-pg", PGUserConfig{WorkMem: i(16), PG: &PGConfig{MaxParallelWorkers: i(8)}})
+	if err != nil {
+		t.Fatalf("SetPGConfig() error = %v", err)
+	}
+
+	want := map[string]interface{}{
+		"work_mem": float64(16),
+		"pg":       map[string]interface{}{"log_min_duration_statement": float64(1000), "max_parallel_workers": float64(8)},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SetPGConfig() got user config = %v, want %v", got, want)
+	}
+}
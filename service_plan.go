@@ -0,0 +1,73 @@
+package aiven
+
+import "fmt"
+
+type (
+	// PlanChangePreview describes the service after changing its plan
+	PlanChangePreview struct {
+		Plan            string
+		NodeCount       int
+		NodeCountChange int
+		NodeMemoryMB    int
+		DiskSpaceMB     int
+		// RequiresRebuild is true when the service is migrated to new nodes, which
+		// Aiven does for every plan change
+		RequiresRebuild bool
+		// PriceChange is the change of the service run-rate, negative when scaling down
+		PriceChange CostEstimate
+	}
+)
+
+// PreviewPlanChange returns what changes when the service is moved to the given plan,
+// without changing it. Additional disk space is kept when the new plan allows it.
+func (h *ServicesHandler) PreviewPlanChange(project, service, newPlan string) (*PlanChangePreview, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := h.client.ServiceTypes.GetPlan(project, s.Type, newPlan)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &PlanChangePreview{
+		Plan:            newPlan,
+		NodeCount:       plan.NodeCount,
+		NodeCountChange: plan.NodeCount - s.NodeCount,
+		NodeMemoryMB:    plan.Regions[s.CloudName].NodeMemoryMB,
+		DiskSpaceMB:     plan.DiskSpaceMB,
+		RequiresRebuild: newPlan != s.Plan,
+		PriceChange:     CostEstimate{Currency: "USD"},
+	}
+
+	if s.DiskSpaceMB > plan.DiskSpaceMB {
+		if plan.DiskSpaceCapMB > 0 && s.DiskSpaceMB > plan.DiskSpaceCapMB {
+			return nil, fmt.Errorf("disk space %d MB of service %s exceeds plan %s limit %d MB",
+				s.DiskSpaceMB, service, newPlan, plan.DiskSpaceCapMB)
+		}
+		p.DiskSpaceMB = s.DiskSpaceMB
+	}
+
+	if !s.Powered {
+		return p, nil
+	}
+
+	current, err := h.estimatedCost(project, s)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := *s
+	changed.Plan = newPlan
+	changed.DiskSpaceMB = p.DiskSpaceMB
+	next, err := h.estimatedCost(project, &changed)
+	if err != nil {
+		return nil, err
+	}
+
+	p.PriceChange.HourlyPrice = next.HourlyPrice - current.HourlyPrice
+	p.PriceChange.MonthlyPrice = next.MonthlyPrice - current.MonthlyPrice
+
+	return p, nil
+}
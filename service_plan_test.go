@@ -0,0 +1,63 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServicesHandler_PreviewPlanChange(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var rsp interface{}
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/service/my-pg"):
+			rsp = ServiceResponse{Service: &Service{
+				Name: "my-pg", Type: "pg", Plan: "startup-4", CloudName: "google-europe-west1",
+				NodeCount: 1, DiskSpaceMB: 100 * 1024, Powered: true,
+			}}
+		case strings.Contains(r.URL.Path, "/pricing/") && strings.Contains(r.URL.Path, "/startup-4/"):
+			rsp = GetServicePlanPricingResponse{BasePriceUSD: "0.1", ExtraDiskPricePerGBUSD: "0.001"}
+		case strings.Contains(r.URL.Path, "/pricing/"):
+			rsp = GetServicePlanPricingResponse{BasePriceUSD: "0.5", ExtraDiskPricePerGBUSD: "0.001"}
+		case strings.HasSuffix(r.URL.Path, "/plans/startup-4"):
+			rsp = GetServicePlanResponse{DiskSpaceMB: 80 * 1024, DiskSpaceCapMB: 400 * 1024, NodeCount: 1}
+		case strings.HasSuffix(r.URL.Path, "/plans/business-8"):
+			rsp = GetServicePlanResponse{
+				DiskSpaceMB: 175 * 1024, DiskSpaceCapMB: 700 * 1024, NodeCount: 2,
+				Regions: map[string]ServicePlanRegion{"google-europe-west1": {NodeMemoryMB: 8192}},
+			}
+		default:
+			t.Errorf("unexpected request %s", r.URL.Path)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(rsp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	got, err := c.Services.PreviewPlanChange("test-pr", "my-pg", "business-8")
+	if err != nil {
+		t.Fatalf("PreviewPlanChange() error = %v", err)
+	}
+
+	if got.NodeCount != 2 || got.NodeCountChange != 1 || got.NodeMemoryMB != 8192 || !got.RequiresRebuild {
+		t.Errorf("PreviewPlanChange() got = %+v", got)
+	}
+
+	// the additional 20 GB disk of the current plan is included in the new plan
+	if got.DiskSpaceMB != 175*1024 {
+		t.Errorf("PreviewPlanChange() got disk space = %v, want %v", got.DiskSpaceMB, 175*1024)
+	}
+
+	if want := 0.5 - (0.1 + 0.02); got.PriceChange.HourlyPrice < want-1e-9 || got.PriceChange.HourlyPrice > want+1e-9 {
+		t.Errorf("PreviewPlanChange() got hourly price change = %v, want %v", got.PriceChange.HourlyPrice, want)
+	}
+}
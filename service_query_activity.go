@@ -0,0 +1,55 @@
+package aiven
+
+type (
+	// ServiceQuery represents a client connection of a PostgreSQL service and the query it runs,
+	// as reported by pg_stat_activity
+	ServiceQuery struct {
+		ApplicationName string  `json:"application_name"`
+		BackendStart    string  `json:"backend_start"`
+		BackendType     string  `json:"backend_type"`
+		BackendXid      *int64  `json:"backend_xid"`
+		BackendXmin     *int64  `json:"backend_xmin"`
+		ClientAddr      string  `json:"client_addr"`
+		ClientHostname  string  `json:"client_hostname"`
+		ClientPort      int     `json:"client_port"`
+		DatabaseName    string  `json:"datname"`
+		Pid             int     `json:"pid"`
+		Query           string  `json:"query"`
+		QueryDuration   float64 `json:"query_duration"`
+		QueryStart      string  `json:"query_start"`
+		State           string  `json:"state"`
+		StateChange     string  `json:"state_change"`
+		Username        string  `json:"usename"`
+		WaitEvent       string  `json:"wait_event"`
+		WaitEventType   string  `json:"wait_event_type"`
+		XactStart       string  `json:"xact_start"`
+	}
+
+	// ServiceQueryActivityRequest are the parameters to list the active queries of a service
+	ServiceQueryActivityRequest struct {
+		Limit   int    `json:"limit,omitempty"`
+		Offset  int    `json:"offset,omitempty"`
+		OrderBy string `json:"order_by,omitempty"`
+	}
+
+	// ServiceQueryActivityResponse represents the response from Aiven for the active queries of a service
+	ServiceQueryActivityResponse struct {
+		APIResponse
+		Queries []*ServiceQuery `json:"queries"`
+	}
+)
+
+// ActiveConnections lists the client connections of a PostgreSQL service with the queries
+// they run. Idle connections are included, their State is `idle`.
+func (h *ServicesHandler) ActiveConnections(project, service string, req ServiceQueryActivityRequest) ([]*ServiceQuery, error) {
+	path := buildPath("project", project, "service", service, "query", "activity")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceQueryActivityResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.Queries, errR
+}
@@ -3,6 +3,8 @@
 
 package aiven
 
+import "strings"
+
 type (
 	// GetServicePlanResponse Aiven API request
 	// GET https://api.aiven.io/v1/project/<project>/service-types/<service_type>/plans/<service_plan>
@@ -11,9 +13,17 @@ type (
 		DiskSpaceCapMB  int `json:"disk_space_cap_mb"`
 		DiskSpaceMB     int `json:"disk_space_mb"`
 		DiskSpaceStepMB int `json:"disk_space_step_mb"`
+		NodeCount       int `json:"node_count"`
+		// Regions holds the node specs of the plan by cloud name
+		Regions map[string]ServicePlanRegion `json:"regions,omitempty"`
 		//TODO: remaining fields
 	}
 
+	// ServicePlanRegion represents the node specs of a service plan in a cloud
+	ServicePlanRegion struct {
+		NodeMemoryMB int `json:"node_memory_mb"`
+	}
+
 	// GetServicePlanPricingResponse Aiven API request
 	// GET https://api.aiven.io/v1/project/<project>/pricing/service-types/<service_type>/plans/<service_plan>/cloud/<cloud>
 	GetServicePlanPricingResponse struct {
@@ -27,8 +37,108 @@ type (
 	ServiceTypesHandler struct {
 		client *Client
 	}
+
+	// ServiceType represents a service type available to a project
+	ServiceType struct {
+		Description            string           `json:"description"`
+		DefaultVersion         string           `json:"default_version,omitempty"`
+		LatestAvailableVersion string           `json:"latest_available_version,omitempty"`
+		UserConfigSchema       UserConfigSchema `json:"user_config_schema"`
+	}
+
+	// UserConfigSchema is the JSON schema of a service or integration user config
+	UserConfigSchema struct {
+		Type       interface{}                 `json:"type,omitempty"`
+		Title      string                      `json:"title,omitempty"`
+		Properties map[string]UserConfigSchema `json:"properties,omitempty"`
+		Required   []string                    `json:"required,omitempty"`
+		Default    interface{}                 `json:"default,omitempty"`
+		Enum       []interface{}               `json:"enum,omitempty"`
+	}
+
+	// ServiceTypesResponse Aiven API response
+	// GET https://api.aiven.io/v1/project/<project>/service_types
+	ServiceTypesResponse struct {
+		APIResponse
+		ServiceTypes map[string]*ServiceType `json:"service_types"`
+	}
+
+	// ServiceCapabilities describes which integrations and user config keys a service type supports
+	ServiceCapabilities struct {
+		ServiceType      string
+		IntegrationTypes []*ServiceIntegrationType
+		UserConfigSchema UserConfigSchema
+	}
 )
 
+// List fetches all service types available to the project from Aiven
+func (h *ServiceTypesHandler) List(project string) (map[string]*ServiceType, error) {
+	path := buildPath("project", project, "service_types")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r ServiceTypesResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.ServiceTypes, errR
+}
+
+// GetCapabilities fetches the integrations and user config keys supported by the service type
+func (h *ServiceTypesHandler) GetCapabilities(project, serviceType string) (*ServiceCapabilities, error) {
+	types, err := h.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	st, ok := types[serviceType]
+	if !ok {
+		return nil, Error{Message: "Service type " + serviceType + " not found", Status: 404}
+	}
+
+	integrationTypes, err := h.client.ServiceIntegrations.ListTypes(project)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ServiceCapabilities{
+		ServiceType:      serviceType,
+		IntegrationTypes: integrationTypes,
+		UserConfigSchema: st.UserConfigSchema,
+	}, nil
+}
+
+// SupportsIntegration returns true if the service type can be either side of the integration type
+func (c *ServiceCapabilities) SupportsIntegration(integrationType string) bool {
+	for _, t := range c.IntegrationTypes {
+		if t.IntegrationType != integrationType {
+			continue
+		}
+
+		if containsString(t.SourceServiceTypes, c.ServiceType) || containsString(t.DestinationServiceTypes, c.ServiceType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportsUserConfigKey returns true if the user config schema contains the key,
+// nested keys are separated with dots, e.g. `pg.max_connections`
+func (c *ServiceCapabilities) SupportsUserConfigKey(key string) bool {
+	schema := c.UserConfigSchema
+	for _, part := range strings.Split(key, ".") {
+		s, ok := schema.Properties[part]
+		if !ok {
+			return false
+		}
+		schema = s
+	}
+
+	return true
+}
+
 // Get fetches the service plan from Aiven
 func (h *ServiceTypesHandler) GetPlan(project, serviceType, servicePlan string) (*GetServicePlanResponse, error) {
 	path := buildPath("project", project, "service-types", serviceType, "plans", servicePlan)
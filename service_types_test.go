@@ -0,0 +1,114 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupServiceTypesTestCase(t *testing.T) (*Client, func(t *testing.T)) {
+	t.Log("setup Service Types test case")
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/project/test-pr/service_types" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(ServiceTypesResponse{
+				ServiceTypes: map[string]*ServiceType{
+					"pg": {
+						Description: "PostgreSQL - Object-Relational Database Management System",
+						UserConfigSchema: UserConfigSchema{
+							Properties: map[string]UserConfigSchema{
+								"ip_filter": {},
+								"pg": {
+									Properties: map[string]UserConfigSchema{
+										"max_connections": {},
+									},
+								},
+							},
+						},
+					},
+				},
+			})
+
+			if err != nil {
+				t.Error(err)
+			}
+			return
+		}
+
+		if r.URL.Path == "/project/test-pr/integration_types" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(ServiceIntegrationTypesResponse{
+				IntegrationTypes: []*ServiceIntegrationType{
+					{
+						IntegrationType:         "metrics",
+						SourceServiceTypes:      []string{"kafka", "pg"},
+						DestinationServiceTypes: []string{"influxdb"},
+					},
+					{
+						IntegrationType:         "mirrormaker",
+						SourceServiceTypes:      []string{"kafka"},
+						DestinationServiceTypes: []string{"kafka_mirrormaker"},
+					},
+				},
+			})
+
+			if err != nil {
+				t.Error(err)
+			}
+			return
+		}
+	}))
+
+	apiurl = ts.URL
+
+	c, err := NewTokenClient("some-random-token", "aiven-go-client-test/"+Version())
+	if err != nil {
+		t.Fatalf("token client error: %s", err)
+	}
+
+	return c, func(t *testing.T) {
+		t.Log("teardown Service Types test case")
+		ts.Close()
+	}
+}
+
+func TestServiceTypesHandler_GetCapabilities(t *testing.T) {
+	c, tearDown := setupServiceTypesTestCase(t)
+	defer tearDown(t)
+
+	if _, err := c.ServiceTypes.GetCapabilities("test-pr", "unknown"); !IsNotFound(err) {
+		t.Errorf("GetCapabilities() error = %v, want not found", err)
+	}
+
+	capabilities, err := c.ServiceTypes.GetCapabilities("test-pr", "pg")
+	if err != nil {
+		t.Fatalf("GetCapabilities() error = %v", err)
+	}
+
+	integrations := map[string]bool{
+		"metrics":     true,
+		"mirrormaker": false,
+		"unknown":     false,
+	}
+	for k, want := range integrations {
+		if got := capabilities.SupportsIntegration(k); got != want {
+			t.Errorf("SupportsIntegration(%s) = %v, want %v", k, got, want)
+		}
+	}
+
+	keys := map[string]bool{
+		"ip_filter":          true,
+		"pg.max_connections": true,
+		"pg.unknown":         false,
+		"kafka":              false,
+	}
+	for k, want := range keys {
+		if got := capabilities.SupportsUserConfigKey(k); got != want {
+			t.Errorf("SupportsUserConfigKey(%s) = %v, want %v", k, got, want)
+		}
+	}
+}
@@ -75,7 +75,54 @@ func (h *ServiceUsersHandler) Create(project, service string, req CreateServiceU
 	return r.User, errR
 }
 
+// CreateWithKafkaACLs creates a Kafka service user and grants it the given ACLs. If any
+// ACL cannot be created the already created ACLs and the user are removed again.
+func (h *ServiceUsersHandler) CreateWithKafkaACLs(
+	project, service, username string,
+	acls []KafkaACLRule,
+) (*ServiceUser, []*KafkaACL, error) {
+	user, err := h.Create(project, service, CreateServiceUserRequest{Username: username})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created []*KafkaACL
+	for _, rule := range acls {
+		acl, err := h.client.KafkaACLs.Create(project, service, CreateKafkaACLRequest{
+			Permission: rule.Permission,
+			Topic:      rule.Topic,
+			Username:   username,
+		})
+		if err != nil {
+			if errR := h.rollbackKafkaUser(project, service, username, created); errR != nil {
+				return nil, nil, fmt.Errorf("%w, rollback failed: %s", err, errR)
+			}
+			return nil, nil, err
+		}
+		created = append(created, acl)
+	}
+
+	return user, created, nil
+}
+
+// rollbackKafkaUser removes the given ACLs and the user
+func (h *ServiceUsersHandler) rollbackKafkaUser(project, service, username string, acls []*KafkaACL) error {
+	for _, acl := range acls {
+		if err := h.client.KafkaACLs.Delete(project, service, acl.ID); err != nil && !IsNotFound(err) {
+			return err
+		}
+	}
+
+	if err := h.Delete(project, service, username); err != nil && !IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
 // List Service Users for given service in Aiven.
+// Users are read from the service info which already carries their credentials
+// (password, access certificate and key), so no follow-up Get is required.
 func (h *ServiceUsersHandler) List(project, serviceName string) ([]*ServiceUser, error) {
 	// Aiven API does not provide list operation for service users, need to get them via service info instead
 	service, err := h.client.Services.Get(project, serviceName)
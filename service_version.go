@@ -0,0 +1,126 @@
+package aiven
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AvailableVersions lists the versions the service type can be created with or upgraded to,
+// from the oldest to the newest, based on the `<service type>_version` user config.
+func (h *ServiceTypesHandler) AvailableVersions(project, serviceType string) ([]string, error) {
+	types, err := h.List(project)
+	if err != nil {
+		return nil, err
+	}
+
+	t, ok := types[serviceType]
+	if !ok {
+		return nil, Error{Message: "Service type " + serviceType + " not found", Status: 404}
+	}
+
+	var versions []string
+	for _, v := range t.UserConfigSchema.Properties[serviceType+"_version"].Enum {
+		if s, ok := v.(string); ok {
+			versions = append(versions, s)
+		}
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersions(versions[i], versions[j]) < 0
+	})
+
+	return versions, nil
+}
+
+// UpgradeKafka upgrades the Kafka version of the service. The target version must be
+// available and the next available version after the current one, as Aiven doesn't
+// allow skipping versions or downgrading. It returns an error without upgrading when the
+// current version of the service is unknown.
+func (h *ServicesHandler) UpgradeKafka(project, service, targetVersion string) (*Service, error) {
+	s, err := h.Get(project, service)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.Type != "kafka" {
+		return nil, fmt.Errorf("service %s of type %s is not a Kafka service", service, s.Type)
+	}
+
+	versions, err := h.client.ServiceTypes.AvailableVersions(project, s.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	current := currentKafkaVersion(s)
+	if err := validateUpgradePath(versions, current, targetVersion); err != nil {
+		return nil, err
+	}
+
+	req := updateRequestFromService(s)
+	req.UserConfig = map[string]interface{}{"kafka_version": targetVersion}
+
+	return h.Update(project, service, req)
+}
+
+// currentKafkaVersion returns the major.minor Kafka version of the service, from the
+// kafka_version user config or, when the service runs the default version, from the
+// running version in the service metadata. It returns an empty string if neither has it.
+func currentKafkaVersion(s *Service) string {
+	if v, ok := s.UserConfig["kafka_version"].(string); ok && v != "" {
+		return v
+	}
+
+	metadata, _ := s.Metadata.(map[string]interface{})
+	v, _ := metadata["kafka_version"].(string)
+	if parts := strings.Split(v, "."); len(parts) > 2 {
+		v = strings.Join(parts[:2], ".")
+	}
+
+	return v
+}
+
+// validateUpgradePath checks that target is the next of the sorted available versions after current
+func validateUpgradePath(versions []string, current, target string) error {
+	if !containsString(versions, target) {
+		return fmt.Errorf("version %s is not available, available versions are %s", target, strings.Join(versions, ", "))
+	}
+
+	if current == "" {
+		return fmt.Errorf("cannot upgrade to version %s, the current version of the service is unknown", target)
+	}
+
+	if compareVersions(target, current) <= 0 {
+		return fmt.Errorf("cannot upgrade from version %s to %s, downgrades are not supported", current, target)
+	}
+
+	for _, v := range versions {
+		if compareVersions(v, current) > 0 && compareVersions(v, target) < 0 {
+			return fmt.Errorf("cannot upgrade from version %s to %s, upgrade to %s first", current, target, v)
+		}
+	}
+
+	return nil
+}
+
+// compareVersions compares dotted versions numerically, e.g. 2.8 < 3.0 < 3.10. It returns
+// a negative number when a is older than b, zero when they are equal and positive otherwise.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+
+		if x != y {
+			return x - y
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,68 @@
+package aiven
+
+import "testing"
+
+func Test_validateUpgradePath(t *testing.T) {
+	versions := []string{"2.8", "3.0", "3.1", "3.10"}
+
+	tests := []struct {
+		name    string
+		current string
+		target  string
+		wantErr bool
+	}{
+		{"next", "3.0", "3.1", false},
+		{"numeric-order", "3.1", "3.10", false},
+		{"unknown-current", "", "3.0", true},
+		{"skip", "2.8", "3.1", true},
+		{"downgrade", "3.1", "3.0", true},
+		{"same", "3.1", "3.1", true},
+		{"unavailable", "3.1", "3.2", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateUpgradePath(versions, tt.current, tt.target); (err != nil) != tt.wantErr {
+				t.Errorf("validateUpgradePath() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_currentKafkaVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		service Service
+		want    string
+	}{
+		{
+			name:    "user-config",
+			service: Service{UserConfig: map[string]interface{}{"kafka_version": "3.1"}},
+			want:    "3.1",
+		},
+		{
+			name:    "metadata",
+			service: Service{Metadata: map[string]interface{}{"kafka_version": "3.10.2"}},
+			want:    "3.10",
+		},
+		{
+			name: "user-config-over-metadata",
+			service: Service{
+				UserConfig: map[string]interface{}{"kafka_version": "3.1"},
+				Metadata:   map[string]interface{}{"kafka_version": "3.0.1"},
+			},
+			want: "3.1",
+		},
+		{
+			name:    "unknown",
+			service: Service{},
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := currentKafkaVersion(&tt.service); got != tt.want {
+				t.Errorf("currentKafkaVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
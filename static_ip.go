@@ -0,0 +1,105 @@
+package aiven
+
+type (
+	// StaticIPsHandler is the client that interacts with the static IP addresses API on Aiven.
+	// Static IPs give services stable egress addresses, e.g. for allowlisting by external APIs.
+	StaticIPsHandler struct {
+		client *Client
+	}
+
+	// StaticIP represents a static IP address reserved for a project
+	StaticIP struct {
+		CloudName             string `json:"cloud_name"`
+		IPAddress             string `json:"ip_address"`
+		ServiceName           string `json:"service_name"`
+		State                 string `json:"state"`
+		StaticIPAddressID     string `json:"static_ip_address_id"`
+		TerminationProtection bool   `json:"termination_protection"`
+	}
+
+	// CreateStaticIPRequest holds the parameters to reserve a new static IP address
+	CreateStaticIPRequest struct {
+		CloudName string `json:"cloud_name"`
+	}
+
+	// AssociateStaticIPRequest holds the parameters to associate a static IP address with a service
+	AssociateStaticIPRequest struct {
+		ServiceName string `json:"service_name"`
+	}
+
+	// StaticIPResponse represents the response from Aiven after interacting with a static IP address
+	StaticIPResponse struct {
+		APIResponse
+		StaticIP
+	}
+
+	// StaticIPListResponse represents the response from Aiven for listing static IP addresses
+	StaticIPListResponse struct {
+		APIResponse
+		StaticIPs []*StaticIP `json:"static_ips"`
+	}
+)
+
+// Create reserves a new static IP address in the given cloud.
+func (h *StaticIPsHandler) Create(project string, req CreateStaticIPRequest) (*StaticIP, error) {
+	path := buildPath("project", project, "static-ips")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var r StaticIPResponse
+	if errR := checkAPIResponse(bts, &r); errR != nil {
+		return nil, errR
+	}
+
+	return &r.StaticIP, nil
+}
+
+// List returns all static IP addresses of the project.
+func (h *StaticIPsHandler) List(project string) ([]*StaticIP, error) {
+	path := buildPath("project", project, "static-ips")
+	bts, err := h.client.doGetRequest(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r StaticIPListResponse
+	errR := checkAPIResponse(bts, &r)
+
+	return r.StaticIPs, errR
+}
+
+// Delete releases the given static IP address.
+func (h *StaticIPsHandler) Delete(project, staticIPAddressID string) error {
+	path := buildPath("project", project, "static-ips", staticIPAddressID)
+	bts, err := h.client.doDeleteRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
+
+// Associate associates the static IP address with a service. The service uses its associated
+// static IPs once `static_ips` is enabled in its user config.
+func (h *StaticIPsHandler) Associate(project, staticIPAddressID string, req AssociateStaticIPRequest) error {
+	path := buildPath("project", project, "static-ips", staticIPAddressID, "association")
+	bts, err := h.client.doPostRequest(path, req)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
+
+// Dissociate removes the association of the static IP address with its service.
+func (h *StaticIPsHandler) Dissociate(project, staticIPAddressID string) error {
+	path := buildPath("project", project, "static-ips", staticIPAddressID, "association")
+	bts, err := h.client.doDeleteRequest(path, nil)
+	if err != nil {
+		return err
+	}
+
+	return checkAPIResponse(bts, nil)
+}
@@ -0,0 +1,38 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStaticIPsHandler_Associate(t *testing.T) {
+	var got AssociateStaticIPRequest
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/project/test-pr/static-ips/ip359373e5e56/association" || r.Method != "POST" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"message": "associated"}`))
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	err := c.StaticIPs.Associate("test-pr", "ip359373e5e56", AssociateStaticIPRequest{ServiceName: "my-pg"})
+	if err != nil {
+		t.Fatalf("Associate() error = %v", err)
+	}
+
+	if got.ServiceName != "my-pg" {
+		t.Errorf("Associate() got service name = %v, want my-pg", got.ServiceName)
+	}
+}
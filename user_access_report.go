@@ -0,0 +1,86 @@
+package aiven
+
+type (
+	// UserAccessReport lists the members of projects with their roles
+	UserAccessReport struct {
+		Entries []UserAccessReportEntry `json:"entries"`
+		Errors  []UserAccessReportError `json:"errors,omitempty"`
+	}
+
+	// UserAccessReportEntry is the membership of a user in a project, either directly or through a team
+	UserAccessReportEntry struct {
+		Project     string   `json:"project"`
+		Email       string   `json:"user_email"`
+		RealName    string   `json:"real_name"`
+		MemberType  string   `json:"member_type"`
+		TeamId      string   `json:"team_id,omitempty"`
+		TeamName    string   `json:"team_name,omitempty"`
+		AuthMethods []string `json:"auth"`
+		Invited     bool     `json:"invited"`
+	}
+
+	// UserAccessReportError is a project whose members could not be listed
+	UserAccessReportError struct {
+		Project string `json:"project"`
+		Error   string `json:"error"`
+	}
+)
+
+// UserAccessReport lists the members and pending invitations of the given projects, or of all
+// projects when none are given. Projects are queried in parallel, those which fail are
+// reported in Errors while the members of the others are still listed. The requests are
+// cancelled with the context of the client, see Client.WithContext.
+func (c *Client) UserAccessReport(projects []string) (*UserAccessReport, error) {
+	if len(projects) == 0 {
+		all, err := c.Projects.List()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range all {
+			projects = append(projects, p.Name)
+		}
+	}
+
+	entries := make([][]UserAccessReportEntry, len(projects))
+	errs := make([]error, len(projects))
+	forEachParallel(len(projects), func(i int) {
+		users, invitations, err := c.ProjectUsers.List(projects[i])
+		if err != nil {
+			errs[i] = err
+			return
+		}
+
+		for _, u := range users {
+			entries[i] = append(entries[i], UserAccessReportEntry{
+				Project:     projects[i],
+				Email:       u.Email,
+				RealName:    u.RealName,
+				MemberType:  u.MemberType,
+				TeamId:      u.TeamId,
+				TeamName:    u.TeamName,
+				AuthMethods: u.AuthMethods,
+			})
+		}
+
+		for _, inv := range invitations {
+			entries[i] = append(entries[i], UserAccessReportEntry{
+				Project:    projects[i],
+				Email:      inv.UserEmail,
+				MemberType: inv.MemberType,
+				Invited:    true,
+			})
+		}
+	})
+
+	r := &UserAccessReport{}
+	for i, p := range projects {
+		if errs[i] != nil {
+			r.Errors = append(r.Errors, UserAccessReportError{Project: p, Error: errs[i].Error()})
+			continue
+		}
+		r.Entries = append(r.Entries, entries[i]...)
+	}
+
+	return r, nil
+}
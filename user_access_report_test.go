@@ -0,0 +1,97 @@
+package aiven
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClient_UserAccessReport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/project/test-pr/users" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			err := json.NewEncoder(w).Encode(ProjectInvitationsAndUsersListResponse{
+				ProjectUsers: []*ProjectUser{
+					{Email: "admin@example.com", MemberType: "admin"},
+				},
+				ProjectInvitations: []*ProjectInvitation{
+					{UserEmail: "invited@example.com", MemberType: "developer"},
+				},
+			})
+
+			if err != nil {
+				t.Error(err)
+			}
+			return
+		}
+
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+
+	c, err := NewTokenClient("some-random-token", "aiven-go-client-test/"+Version())
+	if err != nil {
+		t.Fatalf("token client error: %s", err)
+	}
+
+	got, err := c.UserAccessReport([]string{"test-pr", "forbidden-pr"})
+	if err != nil {
+		t.Fatalf("UserAccessReport() error = %v", err)
+	}
+
+	if len(got.Entries) != 2 || got.Entries[0].MemberType != "admin" || !got.Entries[1].Invited {
+		t.Errorf("UserAccessReport() entries = %+v", got.Entries)
+	}
+
+	if len(got.Errors) != 1 || got.Errors[0].Project != "forbidden-pr" {
+		t.Errorf("UserAccessReport() errors = %+v", got.Errors)
+	}
+}
+
+func TestClient_UserAccessReport_partialErrors(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		var rsp interface{}
+		switch r.URL.Path {
+		case "/project":
+			rsp = ProjectListResponse{Projects: []*Project{{Name: "pr-1"}, {Name: "pr-2"}, {Name: "pr-3"}}}
+		case "/project/pr-2/users":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message": "Project not found"}`))
+			return
+		default:
+			project := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/project/"), "/users")
+			rsp = ProjectInvitationsAndUsersListResponse{
+				ProjectUsers: []*ProjectUser{{Email: "admin@" + project + ".example.com", MemberType: "admin"}},
+			}
+		}
+
+		if err := json.NewEncoder(w).Encode(rsp); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer ts.Close()
+
+	apiurl = ts.URL
+	c := &Client{APIKey: "some-random-token", Client: &http.Client{}}
+	c.Init()
+
+	got, err := c.UserAccessReport(nil)
+	if err != nil {
+		t.Fatalf("UserAccessReport() error = %v", err)
+	}
+
+	if len(got.Entries) != 2 || got.Entries[0].Project != "pr-1" || got.Entries[1].Project != "pr-3" {
+		t.Errorf("UserAccessReport() entries = %+v", got.Entries)
+	}
+
+	if len(got.Errors) != 1 || got.Errors[0].Project != "pr-2" || !strings.Contains(got.Errors[0].Error, "Project not found") {
+		t.Errorf("UserAccessReport() errors = %+v", got.Errors)
+	}
+}
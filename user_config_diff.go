@@ -0,0 +1,117 @@
+package aiven
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+)
+
+const (
+	// ConfigDiffAdded is a key set in the desired config but missing from the actual config
+	ConfigDiffAdded = "added"
+	// ConfigDiffRemoved is a key explicitly unset (null) in the desired config but present in the actual config
+	ConfigDiffRemoved = "removed"
+	// ConfigDiffChanged is a key with a different value in the desired and the actual config
+	ConfigDiffChanged = "changed"
+)
+
+// ConfigDiff represents a single difference between two user configs
+type ConfigDiff struct {
+	// Path is the dot separated path of the key, e.g. `pg.max_connections`
+	Path    string
+	Type    string
+	Desired interface{}
+	Actual  interface{}
+}
+
+// DiffUserConfig compares a desired user config against the actual one returned by Aiven.
+// Following Aiven semantics keys omitted from the desired config keep their current or
+// default value and are not reported, while keys set to null are reported as removed.
+// Numbers are compared by value regardless of how they were decoded.
+func DiffUserConfig(desired, actual map[string]interface{}) []ConfigDiff {
+	var diffs []ConfigDiff
+	diffUserConfig("", desired, actual, &diffs)
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs
+}
+
+func diffUserConfig(prefix string, desired, actual map[string]interface{}, diffs *[]ConfigDiff) {
+	for k, d := range desired {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+
+		a, ok := actual[k]
+		switch {
+		case d == nil:
+			if ok && a != nil {
+				*diffs = append(*diffs, ConfigDiff{Path: path, Type: ConfigDiffRemoved, Actual: a})
+			}
+		case !ok || a == nil:
+			*diffs = append(*diffs, ConfigDiff{Path: path, Type: ConfigDiffAdded, Desired: d})
+		default:
+			dm, dIsMap := d.(map[string]interface{})
+			am, aIsMap := a.(map[string]interface{})
+			if dIsMap && aIsMap {
+				diffUserConfig(path, dm, am, diffs)
+				continue
+			}
+
+			if !configValuesEqual(d, a) {
+				*diffs = append(*diffs, ConfigDiff{Path: path, Type: ConfigDiffChanged, Desired: d, Actual: a})
+			}
+		}
+	}
+}
+
+// configValuesEqual compares two user config values treating all numeric types alike
+func configValuesEqual(a, b interface{}) bool {
+	if isConfigNumber(a) && isConfigNumber(b) {
+		af, errA := ToFloat64(a)
+		bf, errB := ToFloat64(b)
+		return errA == nil && errB == nil && af == bf
+	}
+
+	al, aIsList := a.([]interface{})
+	bl, bIsList := b.([]interface{})
+	if aIsList && bIsList {
+		if len(al) != len(bl) {
+			return false
+		}
+		for i := range al {
+			if !configValuesEqual(al[i], bl[i]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	am, aIsMap := a.(map[string]interface{})
+	bm, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		if len(am) != len(bm) {
+			return false
+		}
+		for k, v := range am {
+			if !configValuesEqual(v, bm[k]) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func isConfigNumber(v interface{}) bool {
+	switch v.(type) {
+	case json.Number, float64, int, int64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,60 @@
+package aiven
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDiffUserConfig(t *testing.T) {
+	actual := map[string]interface{}{
+		"ip_filter":      []interface{}{"0.0.0.0/0"},
+		"backup_hour":    json.Number("3"),
+		"admin_username": "avnadmin",
+		"pg": map[string]interface{}{
+			"max_connections": json.Number("100"),
+			"work_mem":        json.Number("4"),
+		},
+	}
+
+	tests := []struct {
+		name    string
+		desired map[string]interface{}
+		want    []ConfigDiff
+	}{
+		{
+			"defaults-ignored",
+			map[string]interface{}{
+				"backup_hour": 3,
+				"pg": map[string]interface{}{
+					"max_connections": 100.0,
+				},
+			},
+			nil,
+		},
+		{
+			"changes",
+			map[string]interface{}{
+				"ip_filter":      []interface{}{"10.0.0.0/8"},
+				"admin_username": nil,
+				"backup_minute":  30,
+				"pg": map[string]interface{}{
+					"work_mem": 8,
+				},
+			},
+			[]ConfigDiff{
+				{Path: "admin_username", Type: ConfigDiffRemoved, Actual: "avnadmin"},
+				{Path: "backup_minute", Type: ConfigDiffAdded, Desired: 30},
+				{Path: "ip_filter", Type: ConfigDiffChanged, Desired: []interface{}{"10.0.0.0/8"}, Actual: []interface{}{"0.0.0.0/0"}},
+				{Path: "pg.work_mem", Type: ConfigDiffChanged, Desired: 8, Actual: json.Number("4")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DiffUserConfig(tt.desired, actual); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DiffUserConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
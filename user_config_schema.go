@@ -0,0 +1,146 @@
+package aiven
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// Validate checks the user config against the schema: required keys must be set, unknown keys
+// are rejected and values must be of the type the schema declares. Nested objects are
+// validated recursively.
+func (s UserConfigSchema) Validate(userConfig map[string]interface{}) error {
+	return s.validateObject("", userConfig)
+}
+
+func (s UserConfigSchema) validateObject(prefix string, userConfig map[string]interface{}) error {
+	for _, k := range s.Required {
+		if v, ok := userConfig[k]; !ok || v == nil {
+			return fmt.Errorf("user config %s is required", prefix+k)
+		}
+	}
+
+	// Sort the keys to report the same error for the same config every time
+	keys := make([]string, 0, len(userConfig))
+	for k := range userConfig {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		path := prefix + k
+		ps, ok := s.Properties[k]
+		if !ok {
+			if len(s.Properties) > 0 {
+				return fmt.Errorf("user config %s is not supported", path)
+			}
+			continue
+		}
+
+		v := userConfig[k]
+		if !ps.allowsType(v) {
+			return fmt.Errorf("user config %s must be of type %v", path, ps.Type)
+		}
+
+		if m, ok := v.(map[string]interface{}); ok {
+			if err := ps.validateObject(path+".", m); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// WithDefaults returns a copy of the user config with the schema defaults set for the keys
+// which aren't set, i.e. the effective config Aiven applies. Nested objects which are set get
+// their defaults applied recursively. The user config itself is not modified.
+func (s UserConfigSchema) WithDefaults(userConfig map[string]interface{}) map[string]interface{} {
+	r := make(map[string]interface{}, len(s.Properties))
+	for k, v := range userConfig {
+		r[k] = v
+	}
+
+	for k, ps := range s.Properties {
+		v, ok := r[k]
+		if !ok {
+			if ps.Default != nil {
+				r[k] = ps.Default
+			}
+			continue
+		}
+
+		if m, ok := v.(map[string]interface{}); ok && len(ps.Properties) > 0 {
+			r[k] = ps.WithDefaults(m)
+		}
+	}
+
+	return r
+}
+
+// allowsType returns true if the value matches the type, or one of the types, of the schema
+func (s UserConfigSchema) allowsType(v interface{}) bool {
+	switch t := s.Type.(type) {
+	case nil:
+		return true
+	case string:
+		return isSchemaType(t, v)
+	case []interface{}:
+		for _, tt := range t {
+			if name, ok := tt.(string); ok && isSchemaType(name, v) {
+				return true
+			}
+		}
+		return false
+	case []string:
+		for _, tt := range t {
+			if isSchemaType(tt, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// isSchemaType returns true if the value is of the JSON schema type
+func isSchemaType(schemaType string, v interface{}) bool {
+	if v == nil {
+		return schemaType == "null"
+	}
+
+	switch schemaType {
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "number":
+		return isConfigNumber(v) || reflect.TypeOf(v).Kind() == reflect.Float32
+	case "integer":
+		switch n := v.(type) {
+		case json.Number:
+			_, err := n.Int64()
+			return err == nil
+		case float64:
+			return n == math.Trunc(n)
+		}
+		switch reflect.TypeOf(v).Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return true
+		}
+		return false
+	case "array":
+		k := reflect.TypeOf(v).Kind()
+		return k == reflect.Slice || k == reflect.Array
+	case "object":
+		return reflect.TypeOf(v).Kind() == reflect.Map
+	}
+
+	return false
+}
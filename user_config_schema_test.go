@@ -0,0 +1,101 @@
+package aiven
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestUserConfigSchema_Validate(t *testing.T) {
+	schema := UserConfigSchema{
+		Type:     "object",
+		Required: []string{"datadog_api_key"},
+		Properties: map[string]UserConfigSchema{
+			"datadog_api_key":  {Type: "string"},
+			"datadog_tags":     {Type: "array"},
+			"max_jmx_metrics":  {Type: "integer"},
+			"disable_consumer": {Type: []interface{}{"boolean", "null"}},
+			"kafka_custom_metrics": {
+				Type:     "object",
+				Required: []string{"enabled"},
+				Properties: map[string]UserConfigSchema{
+					"enabled": {Type: "boolean"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		userConfig map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			"valid",
+			map[string]interface{}{
+				"datadog_api_key":      "key",
+				"datadog_tags":         []string{"env:test"},
+				"max_jmx_metrics":      json.Number("2000"),
+				"disable_consumer":     nil,
+				"kafka_custom_metrics": map[string]interface{}{"enabled": true},
+			},
+			false,
+		},
+		{"missing required", map[string]interface{}{"max_jmx_metrics": 2000}, true},
+		{"unknown key", map[string]interface{}{"datadog_api_key": "key", "datadog_site": "eu"}, true},
+		{"wrong type", map[string]interface{}{"datadog_api_key": 1}, true},
+		{"fractional integer", map[string]interface{}{"datadog_api_key": "key", "max_jmx_metrics": 1.5}, true},
+		{"nullable", map[string]interface{}{"datadog_api_key": "key", "disable_consumer": true}, false},
+		{
+			"nested missing required",
+			map[string]interface{}{"datadog_api_key": "key", "kafka_custom_metrics": map[string]interface{}{}},
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := schema.Validate(tt.userConfig); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserConfigSchema_WithDefaults(t *testing.T) {
+	schema := UserConfigSchema{
+		Type: "object",
+		Properties: map[string]UserConfigSchema{
+			"datadog_api_key": {Type: "string"},
+			"max_jmx_metrics": {Type: "integer", Default: float64(2000)},
+			"kafka_custom_metrics": {
+				Type: "object",
+				Properties: map[string]UserConfigSchema{
+					"enabled":  {Type: "boolean", Default: false},
+					"interval": {Type: "integer"},
+				},
+			},
+		},
+	}
+
+	userConfig := map[string]interface{}{
+		"datadog_api_key":      "key",
+		"kafka_custom_metrics": map[string]interface{}{"interval": float64(10)},
+	}
+
+	got := schema.WithDefaults(userConfig)
+	want := map[string]interface{}{
+		"datadog_api_key": "key",
+		"max_jmx_metrics": float64(2000),
+		"kafka_custom_metrics": map[string]interface{}{
+			"enabled":  false,
+			"interval": float64(10),
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WithDefaults() got = %v, want %v", got, want)
+	}
+
+	if _, ok := userConfig["max_jmx_metrics"]; ok {
+		t.Errorf("WithDefaults() modified the user config")
+	}
+}
@@ -3,7 +3,6 @@
 package aiven
 
 import (
-	"encoding/json"
 	"errors"
 )
 
@@ -99,7 +98,7 @@ func (h *VPCsHandler) List(project string) ([]*VPC, error) {
 	}
 
 	var response *VPCListResponse
-	if err := json.Unmarshal(rsp, &response); err != nil {
+	if err := unmarshalJSON(rsp, &response); err != nil {
 		return nil, err
 	}
 
@@ -112,7 +111,7 @@ func (h *VPCsHandler) List(project string) ([]*VPC, error) {
 
 func parseVPCResponse(rsp []byte) (*VPC, error) {
 	var response *VPC
-	if err := json.Unmarshal(rsp, &response); err != nil {
+	if err := unmarshalJSON(rsp, &response); err != nil {
 		return nil, err
 	}
 
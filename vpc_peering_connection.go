@@ -2,9 +2,7 @@
 
 package aiven
 
-import (
-	"encoding/json"
-)
+import "encoding/json"
 
 type (
 	// VPCPeeringConnectionsHandler is the client that interacts with the VPC
@@ -24,6 +22,25 @@ type (
 		PeerResourceGroup    string   `json:"peer_resource_group,omitempty"`
 		UserPeerNetworkCIDRs []string `json:"user_peer_network_cidrs,omitempty"`
 	}
+
+	// VPCPeeringConnectionStateInfo is the detail of the state of a peering connection, e.g.
+	// the reason a peering connection is in the INVALID_SPECIFICATION state
+	VPCPeeringConnectionStateInfo struct {
+		Message                   string                             `json:"message"`
+		Type                      string                             `json:"type"`
+		AWSVPCPeeringConnectionID string                             `json:"aws_vpc_peering_connection_id,omitempty"`
+		Warnings                  []VPCPeeringConnectionStateWarning `json:"warnings,omitempty"`
+	}
+
+	// VPCPeeringConnectionStateWarning is a warning about a peering connection, e.g. a
+	// conflicting peering connection in the peer cloud account
+	VPCPeeringConnectionStateWarning struct {
+		Message                              string `json:"message"`
+		Type                                 string `json:"type"`
+		ConflictingAWSAccountID              string `json:"conflicting_aws_account_id,omitempty"`
+		ConflictingAWSVPCID                  string `json:"conflicting_aws_vpc_id,omitempty"`
+		ConflictingAWSVPCPeeringConnectionID string `json:"conflicting_aws_vpc_peering_connection_id,omitempty"`
+	}
 )
 
 // Create the given VPC on Aiven.
@@ -41,7 +58,7 @@ func (h *VPCPeeringConnectionsHandler) Create(
 	}
 
 	var response *VPCPeeringConnection
-	if err := json.Unmarshal(rsp, &response); err != nil {
+	if err := unmarshalJSON(rsp, &response); err != nil {
 		return nil, err
 	}
 
@@ -108,7 +125,8 @@ func (h *VPCPeeringConnectionsHandler) GetVPCPeeringWithResourceGroup(
 	return nil, err
 }
 
-// Get a VPC Peering Connection from Aiven.
+// Get a VPC Peering Connection from Aiven. Aiven checks the peer side of the connection on
+// its own, so getting the connection again is enough to see whether the peer has accepted it.
 func (h *VPCPeeringConnectionsHandler) Get(
 	project string,
 	vpcID string,
@@ -169,3 +187,23 @@ func (h *VPCPeeringConnectionsHandler) List(project, vpcID string) ([]*VPCPeerin
 
 	return vpc.PeeringConnections, nil
 }
+
+// Info returns the typed detail of the state of the peering connection, nil when Aiven
+// didn't report any.
+func (pc *VPCPeeringConnection) Info() (*VPCPeeringConnectionStateInfo, error) {
+	if pc.StateInfo == nil || len(*pc.StateInfo) == 0 {
+		return nil, nil
+	}
+
+	bts, err := json.Marshal(pc.StateInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	var info VPCPeeringConnectionStateInfo
+	if err := json.Unmarshal(bts, &info); err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
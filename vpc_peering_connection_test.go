@@ -0,0 +1,43 @@
+package aiven
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestVPCPeeringConnection_Info(t *testing.T) {
+	stateInfo := map[string]interface{}{
+		"message": "Peering connection is in an invalid state",
+		"type":    "invalid-specification",
+		"warnings": []interface{}{
+			map[string]interface{}{
+				"message":                "Overlapping CIDR",
+				"type":                   "overlapping-peer-vpc-ip-ranges",
+				"conflicting_aws_vpc_id": "vpc-123",
+			},
+		},
+	}
+
+	pc := &VPCPeeringConnection{State: "INVALID_SPECIFICATION", StateInfo: &stateInfo}
+	got, err := pc.Info()
+	if err != nil {
+		t.Fatalf("Info() error = %v", err)
+	}
+
+	want := &VPCPeeringConnectionStateInfo{
+		Message: "Peering connection is in an invalid state",
+		Type:    "invalid-specification",
+		Warnings: []VPCPeeringConnectionStateWarning{{
+			Message:             "Overlapping CIDR",
+			Type:                "overlapping-peer-vpc-ip-ranges",
+			ConflictingAWSVPCID: "vpc-123",
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Info() got = %+v, want %+v", got, want)
+	}
+
+	if got, err := (&VPCPeeringConnection{}).Info(); got != nil || err != nil {
+		t.Errorf("Info() without state info got = %v, %v", got, err)
+	}
+}